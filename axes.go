@@ -0,0 +1,192 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// "Axis" specifies the direction of reductions along an axis.
+type Axis int
+
+const (
+	// Reduce each row into an element of a column vector.
+	EachRow Axis = iota
+
+	// Reduce each column into an element of a row vector.
+	EachColumn
+)
+
+// Return the column vector of sums of the rows of "m" with compensated summation.
+func SumRows(m Matrix) Matrix {
+	return SumAlong(m, EachRow)
+}
+
+// Return the row vector of sums of the columns of "m" with compensated summation.
+func SumColumns(m Matrix) Matrix {
+	return SumAlong(m, EachColumn)
+}
+
+// Return the vector of sums of each row or column of "m" with compensated summation.
+func SumAlong(m Matrix, axis Axis) Matrix {
+	sums := make([]compensated, length(m, axis))
+
+	visit(m, true, func(element float64, row, column int) {
+		sums[indexAlong(axis, row, column)].add(element)
+	})
+
+	return vectorOf(axis, len(sums), func(i int) float64 {
+		return sums[i].value()
+	})
+}
+
+// Return the vector of means of each row or column of "m".
+func MeanAlong(m Matrix, axis Axis) Matrix {
+	size := float64(m.Rows() * m.Columns() / length(m, axis))
+
+	return SumAlong(m, axis).Scalar(1 / size)
+}
+
+// Return the vector of maximum elements of each row or column of "m".
+func MaxAlong(m Matrix, axis Axis) Matrix {
+	values, _ := extremaAlong(m, axis, 1)
+
+	return vectorOf(axis, len(values), func(i int) float64 {
+		return values[i]
+	})
+}
+
+// Return the vector of minimum elements of each row or column of "m".
+func MinAlong(m Matrix, axis Axis) Matrix {
+	values, _ := extremaAlong(m, axis, -1)
+
+	return vectorOf(axis, len(values), func(i int) float64 {
+		return values[i]
+	})
+}
+
+// Return the indexes of the first maximum elements of each row or column of "m".
+// The index is the column for "EachRow" and the row for "EachColumn".
+// NaN is ignored unless all the elements are NaN.
+func ArgMaxAlong(m Matrix, axis Axis) []int {
+	_, indexes := extremaAlong(m, axis, 1)
+
+	return indexes
+}
+
+// Return the indexes of the first minimum elements of each row or column of "m".
+// The index is the column for "EachRow" and the row for "EachColumn".
+// NaN is ignored unless all the elements are NaN.
+func ArgMinAlong(m Matrix, axis Axis) []int {
+	_, indexes := extremaAlong(m, axis, -1)
+
+	return indexes
+}
+
+// Return the vector of the Euclidean norms of each row or column of "m".
+// The norm is NaN for a line with a NaN element, and +Inf for a line with an infinite element otherwise.
+func NormAlong(m Matrix, axis Axis) Matrix {
+	n := length(m, axis)
+	scales := make([]float64, n)
+	squares := make([]float64, n)
+	infinite := make([]bool, n)
+
+	visit(m, true, func(element float64, row, column int) {
+		if element == 0 {
+			return
+		}
+
+		i := indexAlong(axis, row, column)
+
+		// Infinite elements aren't scaled, since "Inf / Inf" is NaN.
+		if math.IsInf(element, 0) {
+			infinite[i] = true
+			return
+		}
+
+		a := math.Abs(element)
+
+		// Scale the squares by the largest absolute value to avoid overflow and underflow.
+		if a > scales[i] {
+			r := scales[i] / a
+			squares[i] = 1 + squares[i]*r*r
+			scales[i] = a
+		} else {
+			r := a / scales[i]
+			squares[i] += r * r
+		}
+	})
+
+	return vectorOf(axis, n, func(i int) float64 {
+		norm := scales[i] * math.Sqrt(squares[i])
+		if infinite[i] && !math.IsNaN(norm) {
+			return math.Inf(1)
+		}
+
+		return norm
+	})
+}
+
+// Find the extrema of each row or column, which are maxima for the positive "sign" and minima otherwise.
+func extremaAlong(m Matrix, axis Axis, sign float64) (values []float64, indexes []int) {
+	n := length(m, axis)
+
+	values = make([]float64, n)
+	indexes = make([]int, n)
+
+	for i := range values {
+		values[i] = math.NaN()
+		indexes[i] = -1
+	}
+
+	visit(m, false, func(element float64, row, column int) {
+		i := indexAlong(axis, row, column)
+		j := indexAlong(axis, column, row)
+
+		switch {
+		case indexes[i] < 0, math.IsNaN(values[i]) && !math.IsNaN(element):
+		case sign*element > sign*values[i]:
+		case element == values[i] && j < indexes[i]:
+		case math.IsNaN(values[i]) && math.IsNaN(element) && j < indexes[i]:
+		default:
+			return
+		}
+
+		values[i] = element
+		indexes[i] = j
+	})
+
+	return values, indexes
+}
+
+// Return the number of results of reductions along "axis".
+func length(m Matrix, axis Axis) int {
+	if axis == EachRow {
+		return m.Rows()
+	}
+
+	return m.Columns()
+}
+
+// Return the index of the result which the element at "row" and "column" is reduced into.
+func indexAlong(axis Axis, row, column int) int {
+	if axis == EachRow {
+		return row
+	}
+
+	return column
+}
+
+// Create the column vector for "EachRow" or the row vector for "EachColumn" with elements "f(i)".
+func vectorOf(axis Axis, n int, f func(i int) float64) Matrix {
+	elements := make([]float64, n)
+	for i := range elements {
+		elements[i] = f(i)
+	}
+
+	if axis == EachRow {
+		return dense.New(n, 1)(elements...)
+	}
+
+	return dense.New(1, n)(elements...)
+}
@@ -0,0 +1,126 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func equalIndexes(x, y []int) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Create the transposed view "[[1, -4], [-2, 5], [3, 5], [0, 0]]".
+func transposedView() Matrix {
+	return dense.New(3, 5)(
+		9, 9, 9, 9, 9,
+		9, 1, -2, 3, 0,
+		9, -4, 5, 5, 0,
+	).View(1, 1, 2, 4).Transpose()
+}
+
+func TestSumAlongRespectsTransposedView(t *testing.T) {
+	for _, m := range []Matrix{transposedView(), cursorOnly{transposedView().(*dense.Matrix)}} {
+		if !SumRows(m).Equal(dense.New(4, 1)(-3, 3, 8, 0)) {
+			t.Fatal("SumRows should return the column vector of sums of rows.")
+		}
+
+		if !SumColumns(m).Equal(dense.New(1, 2)(2, 6)) {
+			t.Fatal("SumColumns should return the row vector of sums of columns.")
+		}
+	}
+}
+
+func TestMeanAlong(t *testing.T) {
+	m := transposedView()
+
+	if !MeanAlong(m, EachRow).Equal(dense.New(4, 1)(-1.5, 1.5, 4, 0)) {
+		t.Fatal("MeanAlong should return the means of rows.")
+	}
+
+	if !MeanAlong(m, EachColumn).Equal(dense.New(1, 2)(0.5, 1.5)) {
+		t.Fatal("MeanAlong should return the means of columns.")
+	}
+}
+
+func TestMaxAndMinAlong(t *testing.T) {
+	m := transposedView()
+
+	if !MaxAlong(m, EachRow).Equal(dense.New(4, 1)(1, 5, 5, 0)) {
+		t.Fatal("MaxAlong should return the maximum elements of rows.")
+	}
+
+	if !MinAlong(m, EachColumn).Equal(dense.New(1, 2)(-2, -4)) {
+		t.Fatal("MinAlong should return the minimum elements of columns.")
+	}
+}
+
+func TestArgMaxAndArgMinAlongReturnFirstIndexes(t *testing.T) {
+	for _, m := range []Matrix{transposedView(), cursorOnly{transposedView().(*dense.Matrix)}} {
+		if indexes := ArgMaxAlong(m, EachRow); !equalIndexes(indexes, []int{0, 1, 1, 0}) {
+			t.Fatalf("ArgMaxAlong should return the first columns of maximum elements, but returns %v.", indexes)
+		}
+
+		if indexes := ArgMaxAlong(m, EachColumn); !equalIndexes(indexes, []int{2, 1}) {
+			t.Fatalf("ArgMaxAlong should return the first rows of maximum elements, but returns %v.", indexes)
+		}
+
+		if indexes := ArgMinAlong(m, EachColumn); !equalIndexes(indexes, []int{1, 0}) {
+			t.Fatalf("ArgMinAlong should return the rows of minimum elements, but returns %v.", indexes)
+		}
+	}
+}
+
+func TestArgMaxAlongIgnoresNaN(t *testing.T) {
+	nan := math.NaN()
+
+	m := dense.New(2, 3)(
+		nan, 1, 2,
+		nan, nan, nan,
+	)
+
+	if indexes := ArgMaxAlong(m, EachRow); !equalIndexes(indexes, []int{2, 0}) {
+		t.Fatalf("ArgMaxAlong should ignore NaN unless all are NaN, but returns %v.", indexes)
+	}
+}
+
+func TestNormAlong(t *testing.T) {
+	m := dense.New(2, 2)(
+		3e200, 0,
+		4e200, 1,
+	)
+
+	norms := NormAlong(m, EachColumn)
+
+	if math.Abs(norms.Get(0, 0)-5e200) > 1e186 || norms.Get(0, 1) != 1 {
+		t.Fatal("NormAlong should return the Euclidean norms of columns.")
+	}
+
+	if rows, columns := NormAlong(m, EachRow).Shape(); rows != 2 || columns != 1 {
+		t.Fatal("NormAlong should return the column vector for rows.")
+	}
+}
+
+func TestNormAlongOfInfiniteElements(t *testing.T) {
+	m := dense.New(2, 2)(
+		math.Inf(1), math.Inf(-1),
+		math.Inf(1), math.NaN(),
+	)
+
+	norms := NormAlong(m, EachRow)
+
+	if !math.IsInf(norms.Get(0, 0), 1) || !math.IsNaN(norms.Get(1, 0)) {
+		t.Fatal("NormAlong should return +Inf for infinite elements and NaN for a NaN element.")
+	}
+}
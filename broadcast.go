@@ -0,0 +1,140 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create the matrix whose elements are "f(x, y)" for the elements "x" of "m" and "y" of "n"
+// broadcast as NumPy: a dimension of size 1 is repeated to match the other.
+// The shape of result is the larger one in each dimension.
+// When a dimension of "m" and "n" is different and neither is 1,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func Broadcast(m, n Matrix, f func(x, y float64) float64) *dense.Matrix {
+	validates.ShapeShouldBeBroadcastable(m, n)
+
+	rows, columns := maxInt(m.Rows(), n.Rows()), maxInt(m.Columns(), n.Columns())
+
+	x := broadcastElements(m, rows, columns)
+	y := broadcastElements(n, rows, columns)
+
+	for i := range x {
+		x[i] = f(x[i], y[i])
+	}
+
+	return dense.New(rows, columns)(x...)
+}
+
+// Add the row vector "v" to each row of "m", and return "m".
+// When "v" is not a row vector with the same number of columns,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func AddRowVector(m, v Matrix) Matrix {
+	return broadcastInto(m, v, true, add)
+}
+
+// Add the column vector "v" to each column of "m", and return "m".
+// When "v" is not a column vector with the same number of rows,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func AddColumnVector(m, v Matrix) Matrix {
+	return broadcastInto(m, v, false, add)
+}
+
+// Multiply each row of "m" by the corresponding element of the column vector "v", and return "m".
+// When "v" is not a column vector with the same number of rows,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func MultiplyRows(m, v Matrix) Matrix {
+	return broadcastInto(m, v, false, multiply)
+}
+
+// Multiply each column of "m" by the corresponding element of the row vector "v", and return "m".
+// When "v" is not a row vector with the same number of columns,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func MultiplyColumns(m, v Matrix) Matrix {
+	return broadcastInto(m, v, true, multiply)
+}
+
+// Divide each row of "m" by the corresponding element of the column vector "v", and return "m".
+// When "v" is not a column vector with the same number of rows,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func DivideRows(m, v Matrix) Matrix {
+	return broadcastInto(m, v, false, divide)
+}
+
+// Divide each column of "m" by the corresponding element of the row vector "v", and return "m".
+// When "v" is not a row vector with the same number of columns,
+// validates.NOT_BROADCASTABLE_PANIC will be caused.
+func DivideColumns(m, v Matrix) Matrix {
+	return broadcastInto(m, v, true, divide)
+}
+
+func add(x, y float64) float64 {
+	return x + y
+}
+
+func multiply(x, y float64) float64 {
+	return x * y
+}
+
+func divide(x, y float64) float64 {
+	return x / y
+}
+
+// Rewrite the elements of "m" with "f" and the row vector "v" for "row" or the column vector otherwise.
+func broadcastInto(m, v Matrix, row bool, f func(x, y float64) float64) Matrix {
+	if row && (v.Rows() != 1 || v.Columns() != m.Columns()) {
+		panic(validates.NOT_BROADCASTABLE_PANIC)
+	}
+
+	if !row && (v.Columns() != 1 || v.Rows() != m.Rows()) {
+		panic(validates.NOT_BROADCASTABLE_PANIC)
+	}
+
+	y := elementsOf(v)
+
+	apply := func(element float64, i, j int) float64 {
+		if row {
+			return f(element, y[j])
+		}
+
+		return f(element, y[i])
+	}
+
+	if d, isDense := m.(*dense.Matrix); isDense {
+		d.Apply(apply)
+		return m
+	}
+
+	x := elementsOf(m)
+	columns := m.Columns()
+
+	for index, element := range x {
+		i, j := index/columns, index%columns
+		m.Update(i, j, apply(element, i, j))
+	}
+
+	return m
+}
+
+// Return the elements of "m" repeated to "rows x columns" in row-major order.
+func broadcastElements(m Matrix, rows, columns int) []float64 {
+	x := elementsOf(m)
+	y := make([]float64, rows*columns)
+
+	mRows, mColumns := m.Shape()
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			y[i*columns+j] = x[(i%mRows)*mColumns+j%mColumns]
+		}
+	}
+
+	return y
+}
+
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+
+	return y
+}
@@ -0,0 +1,94 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestBroadcastFollowsNumPyRules(t *testing.T) {
+	column := dense.New(3, 1)(1, 2, 3)
+	row := dense.New(1, 2)(10, 20)
+
+	r := Broadcast(column, row, add)
+
+	if !r.Equal(dense.New(3, 2)(11, 21, 12, 22, 13, 23)) {
+		t.Fatal("Broadcast should repeat the column vector and the row vector.")
+	}
+
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if !Broadcast(m.Transpose(), dense.New(1, 1)(2), multiply).Equal(dense.New(3, 2)(2, 8, 4, 10, 6, 12)) {
+		t.Fatal("Broadcast should repeat the scalar for the transposed matrix.")
+	}
+}
+
+func TestBroadcastCausesPanicForIncompatibleShapes(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_BROADCASTABLE_PANIC {
+			t.Fatalf("Incompatible shapes should cause %s.", validates.NOT_BROADCASTABLE_PANIC)
+		}
+	}()
+
+	Broadcast(dense.Zeros(3, 2), dense.Zeros(2, 1), add)
+}
+
+func TestRowAndColumnVariantsRewriteReceiver(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if r := AddRowVector(m, dense.New(1, 3)(1, 1, 1)); r != m || !m.Equal(dense.New(2, 3)(2, 3, 4, 5, 6, 7)) {
+		t.Fatal("AddRowVector should add the row vector to each row.")
+	}
+
+	if AddColumnVector(m, dense.New(2, 1)(-2, -5)); !m.Equal(dense.New(2, 3)(0, 1, 2, 0, 1, 2)) {
+		t.Fatal("AddColumnVector should add the column vector to each column.")
+	}
+
+	if MultiplyRows(m, dense.New(2, 1)(1, 3)); !m.Equal(dense.New(2, 3)(0, 1, 2, 0, 3, 6)) {
+		t.Fatal("MultiplyRows should multiply each row by the element.")
+	}
+
+	if MultiplyColumns(m, dense.New(1, 3)(1, 2, 1)); !m.Equal(dense.New(2, 3)(0, 2, 2, 0, 6, 6)) {
+		t.Fatal("MultiplyColumns should multiply each column by the element.")
+	}
+
+	if DivideColumns(m, dense.New(1, 3)(1, 2, 2)); !m.Equal(dense.New(2, 3)(0, 1, 1, 0, 3, 3)) {
+		t.Fatal("DivideColumns should divide each column by the element.")
+	}
+
+	if DivideRows(cursorOnly{m}, dense.New(2, 1)(1, 3)); !m.Equal(dense.New(2, 3)(0, 1, 1, 0, 1, 1)) {
+		t.Fatal("DivideRows should divide each row by the element.")
+	}
+}
+
+func TestDivideColumnsNormalizesView(t *testing.T) {
+	m := dense.New(3, 3)(
+		3, 0, 9,
+		4, 2, 9,
+		9, 9, 9,
+	)
+
+	v := m.View(0, 0, 2, 2)
+	DivideColumns(v, NormAlong(v, EachColumn))
+
+	if !m.Equal(dense.New(3, 3)(0.6, 0, 9, 0.8, 1, 9, 9, 9, 9)) {
+		t.Fatal("DivideColumns should normalize the columns of the view.")
+	}
+}
+
+func TestAddRowVectorCausesPanicForColumnVector(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_BROADCASTABLE_PANIC {
+			t.Fatalf("A column vector should cause %s.", validates.NOT_BROADCASTABLE_PANIC)
+		}
+	}()
+
+	AddRowVector(dense.Zeros(3, 3), dense.Zeros(3, 1))
+}
@@ -0,0 +1,187 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Return the cumulative sums along each row or column of "m" with compensated summation.
+func CumSum(m Matrix, axis Axis) *dense.Matrix {
+	rows, columns := m.Shape()
+	x := elementsOf(m)
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		s := &compensated{}
+
+		for j := 0; j < n; j++ {
+			s.add(x[indexes(j)])
+			x[indexes(j)] = s.value()
+		}
+	})
+
+	return dense.New(rows, columns)(x...)
+}
+
+// Return the cumulative products along each row or column of "m".
+func CumProd(m Matrix, axis Axis) *dense.Matrix {
+	rows, columns := m.Shape()
+	x := elementsOf(m)
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		for j := 1; j < n; j++ {
+			x[indexes(j)] *= x[indexes(j-1)]
+		}
+	})
+
+	return dense.New(rows, columns)(x...)
+}
+
+// Return the "order"-th differences along each row or column of "m",
+// whose number of columns for "EachRow" or rows for "EachColumn" decreases by "order".
+// When "order" is negative or not less than the length of rows or columns,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func Diff(m Matrix, axis Axis, order int) *dense.Matrix {
+	rows, columns := m.Shape()
+	validateOrder(rows, columns, axis, order)
+
+	x := elementsOf(m)
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		for k := 1; k <= order; k++ {
+			for j := 0; j < n-k; j++ {
+				x[indexes(j)] = x[indexes(j+1)] - x[indexes(j)]
+			}
+		}
+	})
+
+	if axis == EachRow {
+		columns -= order
+	} else {
+		rows -= order
+	}
+
+	return dense.New(rows, columns)(leading(x, m.Columns(), rows, columns)...)
+}
+
+// Replace the elements of "m" with the cumulative sums along each row or column, and return "m".
+func CumSumInPlace(m *dense.Matrix, axis Axis) *dense.Matrix {
+	eachLineOf(m, axis, func(get func(j int) float64, set func(j int, element float64), n int) {
+		s := &compensated{}
+
+		for j := 0; j < n; j++ {
+			s.add(get(j))
+			set(j, s.value())
+		}
+	})
+
+	return m
+}
+
+// Replace the elements of "m" with the cumulative products along each row or column, and return "m".
+func CumProdInPlace(m *dense.Matrix, axis Axis) *dense.Matrix {
+	eachLineOf(m, axis, func(get func(j int) float64, set func(j int, element float64), n int) {
+		for j := 1; j < n; j++ {
+			set(j, get(j)*get(j-1))
+		}
+	})
+
+	return m
+}
+
+// Write the "order"-th differences along each row or column into the leading part of "m",
+// and return the view of the part.
+// When "order" is negative or not less than the length of rows or columns,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func DiffInPlace(m *dense.Matrix, axis Axis, order int) *dense.Matrix {
+	rows, columns := m.Shape()
+	validateOrder(rows, columns, axis, order)
+
+	eachLineOf(m, axis, func(get func(j int) float64, set func(j int, element float64), n int) {
+		for k := 1; k <= order; k++ {
+			for j := 0; j < n-k; j++ {
+				set(j, get(j+1)-get(j))
+			}
+		}
+	})
+
+	if axis == EachRow {
+		columns -= order
+	} else {
+		rows -= order
+	}
+
+	return m.View(0, 0, rows, columns).(*dense.Matrix)
+}
+
+func validateOrder(rows, columns int, axis Axis, order int) {
+	size := columns
+	if axis == EachColumn {
+		size = rows
+	}
+
+	if order < 0 || order >= size {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+}
+
+// Return the elements of "m" in row-major order.
+func elementsOf(m Matrix) []float64 {
+	columns := m.Columns()
+	x := make([]float64, m.Rows()*columns)
+
+	visit(m, true, func(element float64, row, column int) {
+		x[row*columns+column] = element
+	})
+
+	return x
+}
+
+// Return the elements of the leading "rows x columns" part of "x" in row-major order,
+// where "x" has "stride" columns.
+func leading(x []float64, stride, rows, columns int) []float64 {
+	y := make([]float64, 0, rows*columns)
+
+	for i := 0; i < rows; i++ {
+		y = append(y, x[i*stride:i*stride+columns]...)
+	}
+
+	return y
+}
+
+// Call "f" for each row or column of the "rows x columns" elements in row-major order
+// with the function mapping the position in the line to the index of elements and the length of line.
+func eachLine(rows, columns int, axis Axis, f func(indexes func(j int) int, n int)) {
+	if axis == EachRow {
+		for i := 0; i < rows; i++ {
+			offset := i * columns
+			f(func(j int) int { return offset + j }, columns)
+		}
+
+		return
+	}
+
+	for i := 0; i < columns; i++ {
+		offset := i
+		f(func(j int) int { return offset + j*columns }, rows)
+	}
+}
+
+// Call "f" for each row or column of "m" with the functions reading and updating
+// the "j"-th element of the line in place and the length of line.
+func eachLineOf(m *dense.Matrix, axis Axis, f func(get func(j int) float64, set func(j int, element float64), n int)) {
+	rows, columns := m.Shape()
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		get := func(j int) float64 {
+			k := indexes(j)
+			return m.Get(k/columns, k%columns)
+		}
+
+		set := func(j int, element float64) {
+			k := indexes(j)
+			m.Update(k/columns, k%columns, element)
+		}
+
+		f(get, set, n)
+	})
+}
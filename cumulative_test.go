@@ -0,0 +1,128 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestCumSum(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if !CumSum(m, EachRow).Equal(dense.New(2, 3)(1, 3, 6, 4, 9, 15)) {
+		t.Fatal("CumSum should return the cumulative sums along rows.")
+	}
+
+	if !CumSum(m.Transpose(), EachColumn).Equal(dense.New(3, 2)(1, 4, 3, 9, 6, 15)) {
+		t.Fatal("CumSum should return the cumulative sums along columns of the transpose.")
+	}
+
+	if !CumSum(cursorOnly{m}, EachColumn).Equal(dense.New(2, 3)(1, 2, 3, 5, 7, 9)) {
+		t.Fatal("CumSum should accept a matrix through cursors.")
+	}
+
+	if m.Get(1, 2) != 6 {
+		t.Fatal("CumSum should keep the argument.")
+	}
+}
+
+func TestCumProdOnRowView(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if !CumProd(m.Row(1), EachRow).Equal(dense.New(1, 3)(4, 20, 120)) {
+		t.Fatal("CumProd should return the cumulative products of the row view.")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	m := dense.New(3, 4)(
+		1, 4, 9, 16,
+		0, 1, 0, 1,
+		2, 2, 2, 2,
+	)
+
+	if !Diff(m, EachRow, 1).Equal(dense.New(3, 3)(3, 5, 7, 1, -1, 1, 0, 0, 0)) {
+		t.Fatal("Diff should return the first differences along rows.")
+	}
+
+	if !Diff(m, EachRow, 2).Equal(dense.New(3, 2)(2, 2, -2, 2, 0, 0)) {
+		t.Fatal("Diff should return the second differences along rows.")
+	}
+
+	if !Diff(m.Column(1), EachColumn, 1).Equal(dense.New(2, 1)(-3, 1)) {
+		t.Fatal("Diff should return the differences of the column view.")
+	}
+
+	if !Diff(m, EachColumn, 0).Equal(m) {
+		t.Fatal("Diff of order 0 should be the copy.")
+	}
+}
+
+func TestDiffCausesPanicForTooLargeOrder(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("A too large order should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	Diff(dense.Zeros(2, 3), EachColumn, 2)
+}
+
+func TestInPlaceVariantsRewriteView(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	)
+
+	v := m.View(1, 1, 2, 2).Transpose().(*dense.Matrix)
+
+	if r := CumSumInPlace(v, EachRow); r != v || !m.Equal(dense.New(3, 3)(1, 2, 3, 4, 5, 6, 7, 13, 15)) {
+		t.Fatal("CumSumInPlace should rewrite only the elements of the view.")
+	}
+
+	if r := CumProdInPlace(m.Row(0).(*dense.Matrix), EachRow); r.Get(0, 2) != 6 || m.Get(1, 0) != 4 {
+		t.Fatal("CumProdInPlace should rewrite the row view.")
+	}
+
+	d := DiffInPlace(m.Column(0).(*dense.Matrix), EachColumn, 1)
+
+	if rows, columns := d.Shape(); rows != 2 || columns != 1 {
+		t.Fatal("DiffInPlace should return the view of differences.")
+	}
+
+	if !m.Column(0).Equal(dense.New(3, 1)(3, 3, 7)) {
+		t.Fatal("DiffInPlace should write the differences into the leading part.")
+	}
+}
+
+func TestInPlaceVariantsAgreeWithCopies(t *testing.T) {
+	create := func() *dense.Matrix {
+		return dense.New(2, 4)(
+			1, 4, 9, 16,
+			2, -1, 0.5, 3,
+		)
+	}
+	m := create()
+
+	for _, axis := range []Axis{EachRow, EachColumn} {
+		if !CumSumInPlace(create(), axis).Equal(CumSum(m, axis)) {
+			t.Fatal("CumSumInPlace should agree with CumSum.")
+		}
+
+		if !CumProdInPlace(create(), axis).Equal(CumProd(m, axis)) {
+			t.Fatal("CumProdInPlace should agree with CumProd.")
+		}
+	}
+
+	if !DiffInPlace(create(), EachRow, 2).Equal(Diff(m, EachRow, 2)) {
+		t.Fatal("DiffInPlace should agree with Diff.")
+	}
+}
@@ -0,0 +1,79 @@
+package decompositions
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Arnoldi" is the set of "k" eigenpairs of a general square matrix
+computed with the implicitly restarted Arnoldi method.
+The matrix is accessed only through "(Matrix).Multiply",
+so this is suitable for a large matrix whose full decomposition is too expensive.
+*/
+type Arnoldi struct {
+	values    []complex128
+	real      *arrays.Array
+	imaginary *arrays.Array
+	residuals []float64
+}
+
+// Compute the "k" eigenpairs of the square matrix "m" specified by "which".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "k" is not in [1, m.Rows()], validates.OUT_OF_RANGE_PANIC will be caused.
+// When the iteration doesn't converge within the maximum number of restarts, an error will be returned.
+func NewArnoldi(m types.Matrix, k int, which Which, s *KrylovSettings) (*Arnoldi, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	operator := func(x []float64) []float64 {
+		return multiplyVector(m, x)
+	}
+
+	kr, tolerance, restarts := newKrylov(operator, m.Rows(), k, false, s)
+
+	r, indices, err := kr.iterate(k, which, tolerance, restarts)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Arnoldi{
+		values:    make([]complex128, k),
+		real:      kr.vectors(r.real, indices),
+		imaginary: kr.vectors(r.imaginary, indices),
+		residuals: make([]float64, k),
+	}
+
+	for i, index := range indices {
+		a.values[i] = r.values[index]
+		a.residuals[i] = r.residuals[index]
+	}
+
+	return a, nil
+}
+
+// Return the eigenvalues in the order of preference specified by "Which".
+// A pair of complex conjugate eigenvalues is ordered so that
+// the one with positive imaginary part comes first.
+func (a *Arnoldi) Values() []complex128 {
+	values := make([]complex128, len(a.values))
+	copy(values, a.values)
+
+	return values
+}
+
+// Return the real and imaginary parts of the eigenvectors.
+// The k-th column is the eigenvector with unit length
+// for the k-th eigenvalue of "(*Arnoldi).Values".
+func (a *Arnoldi) Vectors() (real, imaginary *dense.Matrix) {
+	return a.real.Matrix(), a.imaginary.Matrix()
+}
+
+// Return the estimates of residual norm "|m * x - value * x|" for the eigenpairs.
+func (a *Arnoldi) Residuals() []float64 {
+	residuals := make([]float64, len(a.residuals))
+	copy(residuals, a.residuals)
+
+	return residuals
+}
@@ -0,0 +1,80 @@
+package decompositions
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewArnoldiComputesLargestEigenpairs(t *testing.T) {
+	// The matrix has the eigenvalues "1, 2, ..., 30" except for the pair "3 +- 40i".
+	n := 30
+	m := dense.Zeros(n, n)
+	for i := 0; i < n; i++ {
+		m.Update(i, i, float64(i+1))
+		if i+1 < n {
+			m.Update(i, i+1, 0.5)
+		}
+	}
+	m.Update(0, 0, 3)
+	m.Update(0, 1, 40)
+	m.Update(1, 0, -40)
+	m.Update(1, 1, 3)
+
+	a, err := NewArnoldi(m, 3, LargestMagnitude, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := []complex128{3 + 40i, 3 - 40i, 30}
+
+	for k, value := range a.Values() {
+		if cmplx.Abs(value-expected[k]) > 1e-8 {
+			t.Fatalf("The eigenvalues should be %v, but are %v.", expected, a.Values())
+		}
+	}
+
+	re, im := a.Vectors()
+	residuals := a.Residuals()
+
+	for k, value := range a.Values() {
+		r := 0.0
+
+		for i := 0; i < n; i++ {
+			e := -value * complex(re.Get(i, k), im.Get(i, k))
+			for j := 0; j < n; j++ {
+				e += complex(m.Get(i, j), 0) * complex(re.Get(j, k), im.Get(j, k))
+			}
+
+			r += real(e)*real(e) + imag(e)*imag(e)
+		}
+
+		if math.Abs(math.Sqrt(r)-residuals[k]) > 1e-8 {
+			t.Fatalf("The %d-th residual should be %v, but is estimated as %v.", k, math.Sqrt(r), residuals[k])
+		}
+	}
+}
+
+func TestNewArnoldiComputesSmallestRealParts(t *testing.T) {
+	m := dense.New(4, 4)(
+		4, 1, 0, 0,
+		0, -2, 1, 0,
+		0, 0, 1, 1,
+		0, 0, 0, 7,
+	)
+
+	a, err := NewArnoldi(m, 2, SmallestReal, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := []complex128{-2, 1}
+
+	for k, value := range a.Values() {
+		if cmplx.Abs(value-expected[k]) > 1e-10 {
+			t.Fatalf("The eigenvalues should be %v, but are %v.", expected, a.Values())
+		}
+	}
+}
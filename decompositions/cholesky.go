@@ -0,0 +1,162 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Cholesky" is the decomposition "m = l * l^T" of a symmetric positive definite matrix,
+where "l" is lower triangular with positive diagonal elements.
+*/
+type Cholesky struct {
+	l *arrays.Array
+}
+
+// Compute the Cholesky decomposition of the symmetric positive definite matrix "m".
+// Only the lower triangle of "m" is read.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When "m" is not positive definite, an error will be returned.
+func NewCholesky(m types.Matrix) (*Cholesky, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	a := arrays.Convert(m)
+	n := a.Rows()
+	l := arrays.Zeros(n, n)
+
+	for j := 0; j < n; j++ {
+		s := a.Get(j, j)
+		for k := 0; k < j; k++ {
+			s -= l.Get(j, k) * l.Get(j, k)
+		}
+
+		if s <= 0 || math.IsNaN(s) {
+			return nil, errors.New(NotPositiveDefiniteError)
+		}
+
+		d := math.Sqrt(s)
+		l.Update(j, j, d)
+
+		for i := j + 1; i < n; i++ {
+			s := a.Get(i, j)
+			for k := 0; k < j; k++ {
+				s -= l.Get(i, k) * l.Get(j, k)
+			}
+
+			l.Update(i, j, s/d)
+		}
+	}
+
+	d := &Cholesky{
+		l: l,
+	}
+
+	return d, nil
+}
+
+// Return the lower triangular matrix "l".
+func (d *Cholesky) L() *dense.Matrix {
+	return d.l.Matrix()
+}
+
+// Update the factor to that of "l * l^T + x * x^T" for the column vector "x"
+// with Givens rotations in O(n^2) time.
+// When "x" is not a column vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when the size of "x" is different, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When "x" has a non-finite element, an error will be returned and the factor is kept unchanged.
+func (d *Cholesky) Update(x types.Matrix) error {
+	validates.ShapeShouldBeColumnVector(x)
+	validates.ShapeShouldBeMultipliable(d.l, x)
+
+	l, err := rankOne(d.l, columnOf(x), 1)
+	if err != nil {
+		return err
+	}
+	d.l = l
+
+	return nil
+}
+
+// Downdate the factor to that of "l * l^T - x * x^T" for the column vector "x"
+// with hyperbolic rotations in O(n^2) time.
+// When "x" is not a column vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when the size of "x" is different, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When the result is not positive definite, an error will be returned and the factor is kept unchanged.
+func (d *Cholesky) Downdate(x types.Matrix) error {
+	validates.ShapeShouldBeColumnVector(x)
+	validates.ShapeShouldBeMultipliable(d.l, x)
+
+	l, err := rankOne(d.l, columnOf(x), -1)
+	if err != nil {
+		return err
+	}
+	d.l = l
+
+	return nil
+}
+
+// Return the factor of "l * l^T + sign * x * x^T" as a new array, where "sign" is 1 or -1.
+// "x" is overwritten.
+func rankOne(l *arrays.Array, x []float64, sign float64) (*arrays.Array, error) {
+	l = l.Copy()
+	n := l.Rows()
+
+	for k := 0; k < n; k++ {
+		lkk := l.Get(k, k)
+
+		r := lkk*lkk + sign*x[k]*x[k]
+		if r <= 0 || math.IsNaN(r) || math.IsInf(r, 0) {
+			return nil, errors.New(NotPositiveDefiniteError)
+		}
+		r = math.Sqrt(r)
+
+		c := r / lkk
+		s := x[k] / lkk
+		l.Update(k, k, r)
+
+		for i := k + 1; i < n; i++ {
+			lik := (l.Get(i, k) + sign*s*x[i]) / c
+			l.Update(i, k, lik)
+			x[i] = c*x[i] - s*lik
+		}
+	}
+
+	return l, nil
+}
+
+// Solve "l * x = b" in place by forward substitution.
+func forwardSubstitute(l, b *arrays.Array) {
+	n := l.Rows()
+
+	for j := 0; j < b.Columns(); j++ {
+		for i := 0; i < n; i++ {
+			s := b.Get(i, j)
+			for k := 0; k < i; k++ {
+				s -= l.Get(i, k) * b.Get(k, j)
+			}
+
+			b.Update(i, j, s/l.Get(i, i))
+		}
+	}
+}
+
+// Solve "l^T * x = b" in place by back substitution.
+func backSubstituteTranspose(l, b *arrays.Array) {
+	n := l.Rows()
+
+	for j := 0; j < b.Columns(); j++ {
+		for i := n - 1; i >= 0; i-- {
+			s := b.Get(i, j)
+			for k := i + 1; k < n; k++ {
+				s -= l.Get(k, i) * b.Get(k, j)
+			}
+
+			b.Update(i, j, s/l.Get(i, i))
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestNewCholeskyReconstructsTheOriginal(t *testing.T) {
+	m := dense.New(3, 3)(
+		4, 12, -16,
+		12, 37, -43,
+		-16, -43, 98,
+	)
+
+	d, err := NewCholesky(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	l := dense.New(3, 3)(
+		2, 0, 0,
+		6, 1, 0,
+		-8, 5, 3,
+	)
+
+	if !equalApproximately(d.L(), l, 1e-12) {
+		t.Fatal("The factor l should be the lower triangular Cholesky factor.")
+	}
+
+	if !equalApproximately(d.L().Multiply(d.L().Transpose()), m, 1e-12) {
+		t.Fatal("The product l * l^T should equal to the original matrix.")
+	}
+}
+
+func TestNewCholeskyFailsForIndefiniteMatrix(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		2, 1,
+	)
+
+	if _, err := NewCholesky(m); err == nil || err.Error() != NotPositiveDefiniteError {
+		t.Fatalf("An indefinite matrix should cause %s.", NotPositiveDefiniteError)
+	}
+}
+
+func TestNewCholeskyCausesPanicForNonSquareMatrix(t *testing.T) {
+	m := dense.Zeros(2, 3)
+
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+	NewCholesky(m)
+}
+
+func TestCholeskyUpdateAndDowndate(t *testing.T) {
+	m := dense.New(3, 3)(
+		4, 12, -16,
+		12, 37, -43,
+		-16, -43, 98,
+	)
+	x := dense.New(3, 1)(1, -2, 3)
+
+	d, err := NewCholesky(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if err := d.Update(x); err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	updated := m.Add(x.Multiply(x.Transpose()))
+	if !equalApproximately(d.L().Multiply(d.L().Transpose()), updated, 1e-10) {
+		t.Fatal("The updated factor should be that of m + x * x^T.")
+	}
+
+	if err := d.Downdate(x); err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	l := dense.New(3, 3)(
+		2, 0, 0,
+		6, 1, 0,
+		-8, 5, 3,
+	)
+
+	if !equalApproximately(d.L(), l, 1e-10) {
+		t.Fatal("The downdate should restore the original factor.")
+	}
+}
+
+func TestCholeskyDowndateFailsForIndefiniteResult(t *testing.T) {
+	m := dense.New(2, 2)(
+		2, 1,
+		1, 2,
+	)
+
+	d, err := NewCholesky(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+	l := d.L()
+
+	// "m - x * x^T" has the eigenvalue "3 - 4 < 0".
+	if err := d.Downdate(dense.New(2, 1)(math.Sqrt2, math.Sqrt2)); err == nil || err.Error() != NotPositiveDefiniteError {
+		t.Fatalf("The downdate losing positive definiteness should cause %s.", NotPositiveDefiniteError)
+	}
+
+	if !d.L().Equal(l) {
+		t.Fatal("The failed downdate should keep the factor unchanged.")
+	}
+}
+
+func TestCholeskyUpdateFailsForNonFiniteVector(t *testing.T) {
+	d, err := NewCholesky(dense.New(2, 2)(2, 1, 1, 2))
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+	l := d.L()
+
+	for _, x := range []*dense.Matrix{
+		dense.New(2, 1)(math.NaN(), 1),
+		dense.New(2, 1)(1, math.NaN()),
+		dense.New(2, 1)(math.Inf(1), 1),
+	} {
+		if err := d.Update(x); err == nil || err.Error() != NotPositiveDefiniteError {
+			t.Fatalf("The update with a non-finite element should cause %s.", NotPositiveDefiniteError)
+		}
+
+		if !d.L().Equal(l) {
+			t.Fatal("The failed update should keep the factor unchanged.")
+		}
+	}
+}
+
+func TestCholeskyUpdateCausesPanicForDifferentSize(t *testing.T) {
+	d, err := NewCholesky(dense.New(2, 2)(1, 0, 0, 1))
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	defer func() {
+		if p := recover(); p != validates.NOT_MULTIPLIABLE_PANIC {
+			t.Fatalf("A vector of different size should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+		}
+	}()
+
+	d.Update(dense.New(3, 1)(1, 2, 3))
+}
@@ -0,0 +1,17 @@
+/*
+Package "decompositions" provides factorizations of matrices and eigensolvers.
+
+Factorizations accept any matrix satisfying "types.Matrix" and return results as dense matrices.
+An invalid shape causes a panic defined in "validates" as same as operations of "types.Matrix",
+while a numerical failure is reported as an error value.
+*/
+package decompositions
+
+const (
+	NotConvergedError        = "NotConvergedError"
+	NotPositiveDefiniteError = "NotPositiveDefiniteError"
+	SingularError            = "SingularError"
+	RankDeficientError       = "RankDeficientError"
+	NegativeElementError     = "NegativeElementError"
+	NonFiniteError           = "NonFiniteError"
+)
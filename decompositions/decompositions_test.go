@@ -0,0 +1,25 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Check whether two matrices are equal within the given absolute tolerance.
+func equalApproximately(m, n types.Matrix, tolerance float64) bool {
+	if m.Rows() != n.Rows() || m.Columns() != n.Columns() {
+		return false
+	}
+
+	cursor := m.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if math.Abs(element-n.Get(row, column)) > tolerance {
+			return false
+		}
+	}
+
+	return true
+}
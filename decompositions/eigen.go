@@ -0,0 +1,273 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"Eigen" is the eigendecomposition of a general square matrix.
+The eigenvalues may be complex, and so are the right eigenvectors.
+*/
+type Eigen struct {
+	values    []complex128
+	real      *arrays.Array
+	imaginary *arrays.Array
+}
+
+// Compute the eigenvalues of the square matrix "m".
+// The right eigenvectors are also computed when "vectors" is true.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When the QR iteration doesn't converge, an error will be returned.
+func NewEigen(m types.Matrix, vectors bool) (*Eigen, error) {
+	s, err := NewSchur(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEigenFromSchur(s, vectors), nil
+}
+
+// Compute the eigenvalues from the real Schur decomposition "s".
+// The right eigenvectors are also computed by back substitution when "vectors" is true.
+func NewEigenFromSchur(s *Schur, vectors bool) *Eigen {
+	e := &Eigen{
+		values: s.Eigenvalues(),
+	}
+
+	if vectors {
+		e.real, e.imaginary = schurVectors(s)
+	}
+
+	return e
+}
+
+// Return the eigenvalues in the order of the diagonal blocks of the Schur form.
+func (e *Eigen) Values() []complex128 {
+	values := make([]complex128, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// Check whether the eigenvectors are computed or not.
+func (e *Eigen) HasVectors() bool {
+	return e.real != nil
+}
+
+// Return the real and imaginary parts of the right eigenvectors.
+// The k-th column is the eigenvector normalized to unit length
+// for the k-th eigenvalue of "(*Eigen).Values".
+// When the eigenvectors are not computed, both are nil.
+func (e *Eigen) Vectors() (real, imaginary *dense.Matrix) {
+	if !e.HasVectors() {
+		return nil, nil
+	}
+
+	return e.real.Matrix(), e.imaginary.Matrix()
+}
+
+// Compute the eigenvectors of the original matrix from the Schur decomposition.
+// This is based on the back substitution of the procedure "hqr2" of EISPACK.
+func schurVectors(s *Schur) (re, im *arrays.Array) {
+	h := s.t.Copy()
+	size := h.Rows()
+
+	norm := 0.0
+	for i := 0; i < size; i++ {
+		for j := maxInt(i-1, 0); j < size; j++ {
+			norm += math.Abs(h.Get(i, j))
+		}
+	}
+
+	var vectors *arrays.Array
+
+	if norm == 0 {
+		vectors = s.z.Copy()
+	} else {
+		var p, q, r, w, x, y, v, t, sr float64
+
+		for n := size - 1; n >= 0; n-- {
+			p = real(s.values[n])
+			q = imag(s.values[n])
+
+			if q == 0 {
+				// Compute a real vector.
+				l := n
+				h.Update(n, n, 1)
+
+				for i := n - 1; i >= 0; i-- {
+					w = h.Get(i, i) - p
+					r = 0
+					for j := l; j <= n; j++ {
+						r += h.Get(i, j) * h.Get(j, n)
+					}
+
+					if imag(s.values[i]) < 0 {
+						v = w
+						sr = r
+						continue
+					}
+
+					l = i
+
+					if imag(s.values[i]) == 0 {
+						if w != 0 {
+							h.Update(i, n, -r/w)
+						} else {
+							h.Update(i, n, -r/(arrays.Epsilon*norm))
+						}
+					} else {
+						x = h.Get(i, i+1)
+						y = h.Get(i+1, i)
+						di := real(s.values[i]) - p
+						ei := imag(s.values[i])
+						q = di*di + ei*ei
+						t = (x*sr - v*r) / q
+						h.Update(i, n, t)
+
+						if math.Abs(x) > math.Abs(v) {
+							h.Update(i+1, n, (-r-w*t)/x)
+						} else {
+							h.Update(i+1, n, (-sr-y*t)/v)
+						}
+					}
+
+					// Control overflow.
+					t = math.Abs(h.Get(i, n))
+					if (arrays.Epsilon*t)*t > 1 {
+						for j := i; j <= n; j++ {
+							h.Update(j, n, h.Get(j, n)/t)
+						}
+					}
+				}
+			} else if q < 0 {
+				// Compute a complex vector.
+				l := n - 1
+
+				if math.Abs(h.Get(n, n-1)) > math.Abs(h.Get(n-1, n)) {
+					h.Update(n-1, n-1, q/h.Get(n, n-1))
+					h.Update(n-1, n, -(h.Get(n, n)-p)/h.Get(n, n-1))
+				} else {
+					c := complex(0, -h.Get(n-1, n)) / complex(h.Get(n-1, n-1)-p, q)
+					h.Update(n-1, n-1, real(c))
+					h.Update(n-1, n, imag(c))
+				}
+
+				h.Update(n, n-1, 0)
+				h.Update(n, n, 1)
+
+				var ra, sa, vr, vi float64
+
+				for i := n - 2; i >= 0; i-- {
+					ra = 0
+					sa = 0
+					for j := l; j <= n; j++ {
+						ra += h.Get(i, j) * h.Get(j, n-1)
+						sa += h.Get(i, j) * h.Get(j, n)
+					}
+					w = h.Get(i, i) - p
+
+					if imag(s.values[i]) < 0 {
+						v = w
+						r = ra
+						sr = sa
+						continue
+					}
+
+					l = i
+
+					if imag(s.values[i]) == 0 {
+						c := complex(-ra, -sa) / complex(w, q)
+						h.Update(i, n-1, real(c))
+						h.Update(i, n, imag(c))
+					} else {
+						x = h.Get(i, i+1)
+						y = h.Get(i+1, i)
+						di := real(s.values[i]) - p
+						ei := imag(s.values[i])
+						vr = di*di + ei*ei - q*q
+						vi = di * 2 * q
+
+						if vr == 0 && vi == 0 {
+							vr = arrays.Epsilon * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(v))
+						}
+
+						c := complex(x*r-v*ra+q*sa, x*sr-v*sa-q*ra) / complex(vr, vi)
+						h.Update(i, n-1, real(c))
+						h.Update(i, n, imag(c))
+
+						if math.Abs(x) > math.Abs(v)+math.Abs(q) {
+							h.Update(i+1, n-1, (-ra-w*h.Get(i, n-1)+q*h.Get(i, n))/x)
+							h.Update(i+1, n, (-sa-w*h.Get(i, n)-q*h.Get(i, n-1))/x)
+						} else {
+							c = complex(-r-y*h.Get(i, n-1), -sr-y*h.Get(i, n)) / complex(v, q)
+							h.Update(i+1, n-1, real(c))
+							h.Update(i+1, n, imag(c))
+						}
+					}
+
+					// Control overflow.
+					t = math.Max(math.Abs(h.Get(i, n-1)), math.Abs(h.Get(i, n)))
+					if (arrays.Epsilon*t)*t > 1 {
+						for j := i; j <= n; j++ {
+							h.Update(j, n-1, h.Get(j, n-1)/t)
+							h.Update(j, n, h.Get(j, n)/t)
+						}
+					}
+				}
+			}
+		}
+
+		// Transform the vectors of the quasi-triangular form back to the original basis.
+		for i := 0; i < size; i++ {
+			for j := 0; j < i; j++ {
+				h.Update(i, j, 0)
+			}
+		}
+
+		vectors = s.z.Multiply(h)
+	}
+
+	re = arrays.Zeros(size, size)
+	im = arrays.Zeros(size, size)
+
+	for k := 0; k < size; k++ {
+		switch {
+		case imag(s.values[k]) > 0:
+			for i := 0; i < size; i++ {
+				re.Update(i, k, vectors.Get(i, k))
+				im.Update(i, k, vectors.Get(i, k+1))
+			}
+		case imag(s.values[k]) < 0:
+			for i := 0; i < size; i++ {
+				re.Update(i, k, vectors.Get(i, k-1))
+				im.Update(i, k, -vectors.Get(i, k))
+			}
+		default:
+			for i := 0; i < size; i++ {
+				re.Update(i, k, vectors.Get(i, k))
+			}
+		}
+
+		length := 0.0
+		for i := 0; i < size; i++ {
+			length += re.Get(i, k)*re.Get(i, k) + im.Get(i, k)*im.Get(i, k)
+		}
+		length = math.Sqrt(length)
+
+		if length == 0 {
+			continue
+		}
+
+		for i := 0; i < size; i++ {
+			re.Update(i, k, re.Get(i, k)/length)
+			im.Update(i, k, im.Get(i, k)/length)
+		}
+	}
+
+	return re, im
+}
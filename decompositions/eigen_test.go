@@ -0,0 +1,143 @@
+package decompositions
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Check whether "m * v = lambda * v" holds for every eigenpair.
+func checkEigenpairs(t *testing.T, m types.Matrix, e *Eigen) {
+	re, im := e.Vectors()
+	n := m.Rows()
+
+	for k, value := range e.Values() {
+		for i := 0; i < n; i++ {
+			left := complex(0, 0)
+			for j := 0; j < n; j++ {
+				left += complex(m.Get(i, j), 0) * complex(re.Get(j, k), im.Get(j, k))
+			}
+
+			right := value * complex(re.Get(i, k), im.Get(i, k))
+
+			if cmplx.Abs(left-right) > 1e-10 {
+				t.Fatalf("The eigenpair %d should satisfy m * v = lambda * v.", k)
+			}
+		}
+	}
+}
+
+func TestNewEigenComputesRealEigenpairs(t *testing.T) {
+	// A transition matrix of Markov chain has the eigenvalue 1.
+	m := dense.New(3, 3)(
+		0.5, 0.3, 0.2,
+		0.2, 0.6, 0.2,
+		0.1, 0.2, 0.7,
+	)
+
+	e, err := NewEigen(m, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	found := false
+	for _, v := range e.Values() {
+		if cmplx.Abs(v-1) < 1e-12 {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf("The eigenvalue 1 should be found in %v.", e.Values())
+	}
+
+	checkEigenpairs(t, m, e)
+}
+
+func TestNewEigenComputesComplexEigenpairs(t *testing.T) {
+	m := dense.New(4, 4)(
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+		-1, 2, -3, 1,
+	)
+
+	e, err := NewEigen(m, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	complexes := 0
+	for _, v := range e.Values() {
+		if imag(v) != 0 {
+			complexes++
+		}
+	}
+
+	if complexes == 0 {
+		t.Fatalf("The eigenvalues should contain complex ones, but are %v.", e.Values())
+	}
+
+	checkEigenpairs(t, m, e)
+}
+
+func TestNewEigenNormalizesVectors(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, -2,
+		3, 4,
+	)
+
+	e, err := NewEigen(m, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	re, im := e.Vectors()
+
+	for k := 0; k < 2; k++ {
+		length := 0.0
+		for i := 0; i < 2; i++ {
+			length += re.Get(i, k)*re.Get(i, k) + im.Get(i, k)*im.Get(i, k)
+		}
+
+		if math.Abs(length-1) > 1e-12 {
+			t.Fatalf("The eigenvector %d should have unit length.", k)
+		}
+	}
+}
+
+func TestNewEigenSkipsVectors(t *testing.T) {
+	m := dense.New(2, 2)(
+		2, 0,
+		0, 3,
+	)
+
+	e, err := NewEigen(m, false)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if re, im := e.Vectors(); e.HasVectors() || re != nil || im != nil {
+		t.Fatal("The eigenvectors should not be computed.")
+	}
+}
+
+func TestNewEigenHandlesZeroMatrix(t *testing.T) {
+	m := dense.Zeros(3, 3)
+
+	e, err := NewEigen(m, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	for _, v := range e.Values() {
+		if v != 0 {
+			t.Fatalf("The eigenvalues should be zero, but are %v.", e.Values())
+		}
+	}
+
+	checkEigenpairs(t, m, e)
+}
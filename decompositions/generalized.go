@@ -0,0 +1,394 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// The maximum number of QZ iterations spent on each eigenvalue.
+const qzIterations = 100
+
+/*
+"GeneralizedSymmetricEigen" is the solution of the generalized eigenproblem "a * x = lambda * b * x",
+where "a" is symmetric and "b" is symmetric positive definite.
+The eigenvalues are real and the eigenvectors are b-orthonormal, that is, "x^T * b * x = I".
+*/
+type GeneralizedSymmetricEigen struct {
+	values  []float64
+	vectors *arrays.Array
+}
+
+// Solve the generalized eigenproblem "a * x = lambda * b * x"
+// by reducing it to the standard symmetric eigenproblem with the Cholesky decomposition of "b".
+// Only the lower triangles of "a" and "b" are read.
+// When "a" or "b" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when their shapes are different, validates.DIFFERENT_SIZE_PANIC will be caused.
+// When "b" is not positive definite or the iteration doesn't converge, an error will be returned.
+func NewGeneralizedSymmetricEigen(a, b types.Matrix) (*GeneralizedSymmetricEigen, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeSame(a, b)
+
+	cholesky, err := NewCholesky(b)
+	if err != nil {
+		return nil, err
+	}
+	l := cholesky.l
+
+	c := arrays.Convert(a)
+	n := c.Rows()
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			c.Update(i, j, c.Get(j, i))
+		}
+	}
+
+	// Compute "l^-1 * a * l^-T" as "l^-1 * (l^-1 * a)^T".
+	forwardSubstitute(l, c)
+	c = c.Transpose()
+	forwardSubstitute(l, c)
+
+	s, err := NewSymmetricEigen(c.Matrix())
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := s.vectors
+	backSubstituteTranspose(l, vectors)
+
+	g := &GeneralizedSymmetricEigen{
+		values:  s.values,
+		vectors: vectors,
+	}
+
+	return g, nil
+}
+
+// Return the eigenvalues in ascending order.
+func (g *GeneralizedSymmetricEigen) Values() []float64 {
+	values := make([]float64, len(g.values))
+	copy(values, g.values)
+
+	return values
+}
+
+// Return the b-orthonormal matrix whose k-th column is the eigenvector
+// for the k-th eigenvalue of "(*GeneralizedSymmetricEigen).Values".
+func (g *GeneralizedSymmetricEigen) Vectors() *dense.Matrix {
+	return g.vectors.Matrix()
+}
+
+/*
+"GeneralizedEigen" is the solution of the generalized eigenproblem "a * x = lambda * b * x"
+for a general pair of square matrices.
+Each eigenvalue is represented as the ratio "alpha / beta",
+where "beta" is zero for an infinite eigenvalue.
+*/
+type GeneralizedEigen struct {
+	alphas []complex128
+	betas  []float64
+}
+
+// Compute the generalized eigenvalues of the pair "a" and "b" with the QZ algorithm.
+// Unlike "NewGeneralizedSymmetricEigen", "b" may be singular.
+// When "a" or "b" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when their shapes are different, validates.DIFFERENT_SIZE_PANIC will be caused.
+// When the QZ iteration doesn't converge, an error will be returned.
+func NewGeneralizedEigen(a, b types.Matrix) (*GeneralizedEigen, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeSame(a, b)
+
+	h := newComplexArray(arrays.Convert(a))
+	t := newComplexArray(arrays.Convert(b))
+
+	reduceHessenbergTriangular(h, t)
+
+	alphas, betas, err := qz(h, t)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &GeneralizedEigen{
+		alphas: alphas,
+		betas:  betas,
+	}
+
+	return g, nil
+}
+
+// Return the numerators of the eigenvalues.
+func (g *GeneralizedEigen) Alphas() []complex128 {
+	alphas := make([]complex128, len(g.alphas))
+	copy(alphas, g.alphas)
+
+	return alphas
+}
+
+// Return the non-negative denominators of the eigenvalues.
+func (g *GeneralizedEigen) Betas() []float64 {
+	betas := make([]float64, len(g.betas))
+	copy(betas, g.betas)
+
+	return betas
+}
+
+// Return the eigenvalues "alpha / beta".
+// An infinite eigenvalue is represented as cmplx.Inf().
+func (g *GeneralizedEigen) Values() []complex128 {
+	values := make([]complex128, len(g.alphas))
+
+	for i, alpha := range g.alphas {
+		if g.betas[i] == 0 {
+			values[i] = cmplx.Inf()
+		} else {
+			values[i] = alpha / complex(g.betas[i], 0)
+		}
+	}
+
+	return values
+}
+
+// "complexArray" is a square array of complex128 used by the QZ algorithm.
+type complexArray [][]complex128
+
+func newComplexArray(a *arrays.Array) complexArray {
+	c := make(complexArray, a.Rows())
+
+	for i := range c {
+		c[i] = make([]complex128, a.Columns())
+		for j := range c[i] {
+			c[i][j] = complex(a.Get(i, j), 0)
+		}
+	}
+
+	return c
+}
+
+func (c complexArray) norm() float64 {
+	norm := 0.0
+
+	for _, row := range c {
+		for _, element := range row {
+			norm = math.Hypot(norm, cmplx.Abs(element))
+		}
+	}
+
+	return norm
+}
+
+// Compute a rotation "[c, s; -conj(s), c]" which maps "(x, y)" to "(r, 0)".
+func givensComplex(x, y complex128) (c float64, s, r complex128) {
+	if y == 0 {
+		return 1, 0, x
+	}
+
+	if x == 0 {
+		return 0, 1, y
+	}
+
+	ax := cmplx.Abs(x)
+	norm := math.Hypot(ax, cmplx.Abs(y))
+	phase := x / complex(ax, 0)
+
+	c = ax / norm
+	s = phase * cmplx.Conj(y) / complex(norm, 0)
+	r = phase * complex(norm, 0)
+
+	return c, s, r
+}
+
+// Apply the rotation to the pair "(x, y)".
+func rotateComplex(x, y *complex128, c float64, s complex128) {
+	u := complex(c, 0)*(*x) + s*(*y)
+	*y = -cmplx.Conj(s)*(*x) + complex(c, 0)*(*y)
+	*x = u
+}
+
+// Apply the rotation to the rows "i" and "k" on the columns "from" to "to".
+func rotateRows(m complexArray, i, k, from, to int, c float64, s complex128) {
+	for j := from; j <= to; j++ {
+		rotateComplex(&m[i][j], &m[k][j], c, s)
+	}
+}
+
+// Apply the rotation to the columns "j" and "k" on the rows "from" to "to".
+func rotateColumns(m complexArray, j, k, from, to int, c float64, s complex128) {
+	for i := from; i <= to; i++ {
+		rotateComplex(&m[i][j], &m[i][k], c, s)
+	}
+}
+
+// Reduce the pair "(h, t)" in place so that "h" is upper Hessenberg and "t" is upper triangular.
+func reduceHessenbergTriangular(h, t complexArray) {
+	n := len(h)
+
+	for j := 0; j < n-1; j++ {
+		for i := n - 1; i > j; i-- {
+			c, s, r := givensComplex(t[i-1][j], t[i][j])
+			rotateRows(t, i-1, i, j+1, n-1, c, s)
+			rotateRows(h, i-1, i, 0, n-1, c, s)
+			t[i-1][j], t[i][j] = r, 0
+		}
+	}
+
+	for j := 0; j < n-2; j++ {
+		for i := n - 1; i > j+1; i-- {
+			c, s, r := givensComplex(h[i-1][j], h[i][j])
+			rotateRows(h, i-1, i, j+1, n-1, c, s)
+			rotateRows(t, i-1, i, i-1, n-1, c, s)
+			h[i-1][j], h[i][j] = r, 0
+
+			c, s, r = givensComplex(t[i][i], t[i][i-1])
+			rotateColumns(t, i, i-1, 0, i-1, c, s)
+			rotateColumns(h, i, i-1, 0, n-1, c, s)
+			t[i][i], t[i][i-1] = r, 0
+		}
+	}
+}
+
+// Compute the generalized eigenvalues of the Hessenberg-triangular pair "(h, t)"
+// with the single-shift QZ iteration in complex arithmetic.
+func qz(h, t complexArray) (alphas []complex128, betas []float64, err error) {
+	n := len(h)
+
+	alphas = make([]complex128, n)
+	betas = make([]float64, n)
+
+	hTolerance := arrays.Epsilon * math.Max(h.norm(), 1e-300)
+	tTolerance := arrays.Epsilon * math.Max(t.norm(), 1e-300)
+
+	shift := complex(0, 0)
+	iterations := 0
+
+	last := n - 1
+
+	for last >= 0 {
+		// Look for a negligible sub-diagonal element.
+		first := last
+		for first > 0 {
+			if cmplx.Abs(h[first][first-1]) <= hTolerance {
+				h[first][first-1] = 0
+				break
+			}
+			first--
+		}
+
+		if first == last {
+			alphas[last], betas[last] = normalizeBeta(h[last][last], t[last][last], tTolerance)
+
+			last--
+			iterations = 0
+			continue
+		}
+
+		// Look for a negligible diagonal element of "t", which indicates an infinite eigenvalue.
+		zero := -1
+		for j := first; j <= last; j++ {
+			if cmplx.Abs(t[j][j]) <= tTolerance {
+				t[j][j] = 0
+				zero = j
+				break
+			}
+		}
+
+		if zero >= 0 {
+			// Chase the zero down to the bottom of the block.
+			for j := zero; j < last; j++ {
+				c, s, r := givensComplex(t[j][j+1], t[j+1][j+1])
+				rotateRows(t, j, j+1, j+2, last, c, s)
+				rotateRows(h, j, j+1, maxInt(j-1, first), last, c, s)
+				t[j][j+1], t[j+1][j+1] = r, 0
+
+				if j > first {
+					c, s, r = givensComplex(h[j+1][j], h[j+1][j-1])
+					rotateColumns(h, j, j-1, first, j, c, s)
+					rotateColumns(t, j, j-1, first, j, c, s)
+					h[j+1][j], h[j+1][j-1] = r, 0
+				}
+			}
+
+			c, s, r := givensComplex(h[last][last], h[last][last-1])
+			rotateColumns(h, last, last-1, first, last-1, c, s)
+			rotateColumns(t, last, last-1, first, last, c, s)
+			h[last][last], h[last][last-1] = r, 0
+
+			continue
+		}
+
+		if iterations >= qzIterations {
+			return nil, nil, errors.New(NotConvergedError)
+		}
+		iterations++
+
+		// Use an exceptional shift to break a cycle.
+		if iterations%10 == 0 {
+			shift += h[last][last] / t[last][last]
+		} else {
+			shift = wilkinsonShift(h, t, last)
+		}
+
+		// Perform a QZ sweep on the block from "first" to "last".
+		c, s, _ := givensComplex(h[first][first]-shift*t[first][first], h[first+1][first])
+
+		for j := first; j < last; j++ {
+			if j > first {
+				var r complex128
+				c, s, r = givensComplex(h[j][j-1], h[j+1][j-1])
+				h[j][j-1], h[j+1][j-1] = r, 0
+			}
+
+			rotateRows(h, j, j+1, j, last, c, s)
+			rotateRows(t, j, j+1, j, last, c, s)
+
+			var r complex128
+			c, s, r = givensComplex(t[j+1][j+1], t[j+1][j])
+			t[j+1][j+1], t[j+1][j] = r, 0
+
+			rotateColumns(h, j+1, j, first, minInt(j+2, last), c, s)
+			rotateColumns(t, j+1, j, first, j, c, s)
+		}
+	}
+
+	return alphas, betas, nil
+}
+
+// Compute the eigenvalue of the trailing 2 x 2 pencil closer to "h[last][last] / t[last][last]".
+func wilkinsonShift(h, t complexArray, last int) complex128 {
+	a11, a12 := h[last-1][last-1], h[last-1][last]
+	a21, a22 := h[last][last-1], h[last][last]
+	b11, b12, b22 := t[last-1][last-1], t[last-1][last], t[last][last]
+
+	// Solve "det(a - lambda * b) = 0", which is quadratic in "lambda".
+	qa := b11 * b22
+	qb := -(a11*b22 + a22*b11 - a21*b12)
+	qc := a11*a22 - a21*a12
+
+	d := cmplx.Sqrt(qb*qb - 4*qa*qc)
+
+	first := (-qb + d) / (2 * qa)
+	second := (-qb - d) / (2 * qa)
+
+	target := a22 / b22
+	if cmplx.Abs(first-target) < cmplx.Abs(second-target) {
+		return first
+	}
+
+	return second
+}
+
+// Scale "alpha" and "beta" so that "beta" is real and non-negative.
+func normalizeBeta(alpha, beta complex128, tolerance float64) (complex128, float64) {
+	if cmplx.Abs(beta) <= tolerance {
+		return alpha, 0
+	}
+
+	phase := cmplx.Conj(beta) / complex(cmplx.Abs(beta), 0)
+
+	return alpha * phase, cmplx.Abs(beta)
+}
@@ -0,0 +1,188 @@
+package decompositions
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestNewGeneralizedSymmetricEigenComputesEigenpairs(t *testing.T) {
+	a := dense.New(3, 3)(
+		2, 1, 0,
+		1, 3, 1,
+		0, 1, 4,
+	)
+
+	b := dense.New(3, 3)(
+		4, 1, 0,
+		1, 3, 0,
+		0, 0, 2,
+	)
+
+	g, err := NewGeneralizedSymmetricEigen(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	x := g.Vectors()
+	d := dense.Zeros(3, 3)
+	for i, value := range g.Values() {
+		d.Update(i, i, value)
+	}
+
+	if !equalApproximately(a.Multiply(x), b.Multiply(x).Multiply(d), 1e-12) {
+		t.Fatal("The eigenpairs should satisfy a * x = b * x * diag(values).")
+	}
+
+	i := dense.New(3, 3)(
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	)
+
+	if !equalApproximately(x.Transpose().Multiply(b).Multiply(x), i, 1e-12) {
+		t.Fatal("The eigenvectors should be b-orthonormal.")
+	}
+}
+
+func TestNewGeneralizedSymmetricEigenFailsForIndefiniteB(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 0,
+		0, 1,
+	)
+
+	b := dense.New(2, 2)(
+		1, 0,
+		0, -1,
+	)
+
+	if _, err := NewGeneralizedSymmetricEigen(a, b); err == nil || err.Error() != NotPositiveDefiniteError {
+		t.Fatalf("An indefinite b should cause %s.", NotPositiveDefiniteError)
+	}
+}
+
+func TestNewGeneralizedSymmetricEigenCausesPanicForDifferentShape(t *testing.T) {
+	a := dense.Zeros(2, 2)
+	b := dense.Zeros(3, 3)
+
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf("Matrices with different shapes should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+	}()
+	NewGeneralizedSymmetricEigen(a, b)
+}
+
+func TestNewGeneralizedEigenComputesFiniteEigenvalues(t *testing.T) {
+	a := dense.New(3, 3)(
+		1, 2, 3,
+		-1, 0, 2,
+		3, 1, -2,
+	)
+
+	b := dense.New(3, 3)(
+		2, 0, 1,
+		0, 1, 0,
+		1, 0, 3,
+	)
+
+	g, err := NewGeneralizedEigen(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	// Each eigenvalue should make "a - lambda * b" singular.
+	for _, value := range g.Values() {
+		m := make([][]complex128, 3)
+		for i := range m {
+			m[i] = make([]complex128, 3)
+			for j := range m[i] {
+				m[i][j] = complex(a.Get(i, j), 0) - value*complex(b.Get(i, j), 0)
+			}
+		}
+
+		det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+			m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+			m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+
+		if cmplx.Abs(det) > 1e-10 {
+			t.Fatalf("The eigenvalue %v should make a - lambda * b singular.", value)
+		}
+	}
+}
+
+func TestNewGeneralizedEigenFindsInfiniteEigenvalue(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	b := dense.New(2, 2)(
+		1, 0,
+		0, 0,
+	)
+
+	g, err := NewGeneralizedEigen(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	values := g.Values()
+	infinites := 0
+	finite := complex(0, 0)
+
+	for _, value := range values {
+		if cmplx.IsInf(value) {
+			infinites++
+		} else {
+			finite = value
+		}
+	}
+
+	// det(a - lambda * b) = (1 - lambda) * 4 - 6 has the single root -0.5.
+	if infinites != 1 || cmplx.Abs(finite-complex(-0.5, 0)) > 1e-12 {
+		t.Fatalf("The eigenvalues should be -0.5 and infinity, but are %v.", values)
+	}
+}
+
+func TestNewGeneralizedEigenAgreesWithSymmetricPath(t *testing.T) {
+	a := dense.New(3, 3)(
+		2, 1, 0,
+		1, 3, 1,
+		0, 1, 4,
+	)
+
+	b := dense.New(3, 3)(
+		4, 1, 0,
+		1, 3, 0,
+		0, 0, 2,
+	)
+
+	s, err := NewGeneralizedSymmetricEigen(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	g, err := NewGeneralizedEigen(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	values := []float64{}
+	for _, value := range g.Values() {
+		values = append(values, real(value))
+	}
+	sort.Float64s(values)
+
+	for i, value := range s.Values() {
+		if math.Abs(value-values[i]) > 1e-10 {
+			t.Fatalf("The eigenvalues should be %v, but are %v.", s.Values(), values)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package decompositions
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// The number of orthogonalization passes applied to each column.
+const gramSchmidtPasses = 2
+
+// Orthonormalize the columns of "m" with the modified Gram-Schmidt process,
+// where each column is orthogonalized twice to keep the orthogonality against rounding errors.
+// The result has orthonormal columns spanning the same subspaces as the leading columns of "m".
+// When a column is linearly dependent on the previous ones within the tolerance "tolerance * |column|",
+// an error will be returned. When "tolerance" is not positive, "rows * epsilon" is used.
+func GramSchmidt(m types.Matrix, tolerance float64) (*dense.Matrix, error) {
+	rows, columns := m.Shape()
+
+	if tolerance <= 0 {
+		tolerance = float64(rows) * arrays.Epsilon
+	}
+
+	a := arrays.Convert(m).Transpose()
+	q := make([][]float64, columns)
+
+	for j := 0; j < columns; j++ {
+		v := a.Elements()[j*rows : (j+1)*rows]
+		length := normOf(v)
+
+		for pass := 0; pass < gramSchmidtPasses; pass++ {
+			for _, u := range q[:j] {
+				d := dotOf(u, v)
+				for i := range v {
+					v[i] -= d * u[i]
+				}
+			}
+		}
+
+		remaining := normOf(v)
+		if remaining == 0 || remaining <= tolerance*length {
+			return nil, errors.New(RankDeficientError)
+		}
+
+		for i := range v {
+			v[i] /= remaining
+		}
+		q[j] = v
+	}
+
+	return a.Transpose().Matrix(), nil
+}
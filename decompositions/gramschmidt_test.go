@@ -0,0 +1,62 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestGramSchmidtCreatesOrthonormalColumns(t *testing.T) {
+	// The columns are nearly dependent, where the classical process loses the orthogonality.
+	e := 1e-8
+	m := dense.New(4, 3)(
+		1, 1, 1,
+		e, 0, 0,
+		0, e, 0,
+		0, 0, e,
+	)
+
+	q, err := GramSchmidt(m, 0)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(q.Transpose().Multiply(q), dense.New(3, 3)(1, 0, 0, 0, 1, 0, 0, 0, 1), 1e-14) {
+		t.Fatal("The columns should be orthonormal.")
+	}
+
+	// The first column spans the same subspace as the original one.
+	if q.Get(0, 0) <= 0 || q.Get(2, 0) != 0 {
+		t.Fatal("The first column should be the normalized first column of m.")
+	}
+}
+
+func TestGramSchmidtFailsForDependentColumns(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 0,
+		2, 4, 1,
+		3, 6, 0,
+	)
+
+	if _, err := GramSchmidt(m, 0); err == nil || err.Error() != RankDeficientError {
+		t.Fatalf("The dependent columns should cause %s.", RankDeficientError)
+	}
+}
+
+func TestGramSchmidtAtExtremeMagnitudes(t *testing.T) {
+	for _, scale := range []float64{1e-170, 1e170} {
+		m := dense.New(2, 2)(
+			scale, scale,
+			0, scale,
+		)
+
+		q, err := GramSchmidt(m, 0)
+		if err != nil {
+			t.Fatalf("An unexpected error occured for the scale %v: %s", scale, err)
+		}
+
+		if !equalApproximately(q, dense.New(2, 2)(1, 0, 0, 1), 1e-12) {
+			t.Fatalf("The columns should be orthonormalized for the scale %v.", scale)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package decompositions
+
+import (
+	"math/big"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"Hermite" is the Hermite normal form "u * m = h" of an integer matrix,
+where "u" is unimodular and "h" is in row echelon form
+with positive pivots and the elements above each pivot in [0, pivot).
+The elements are arbitrary-precision integers, so they never overflow.
+*/
+type Hermite struct {
+	h integers
+	u integers
+}
+
+// Compute the Hermite normal form of the integer matrix "m".
+// When "m" has an element which is not an integer, "*NonIntegralError" will be returned.
+func NewHermite(m types.Matrix) (*Hermite, error) {
+	h, err := integersOf(m)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, columns := m.Shape()
+	u := identityIntegers(rows)
+
+	for r, j := 0, 0; r < rows && j < columns; j++ {
+		// Accumulate the gcd of the column into the pivot row.
+		for i := r + 1; i < rows; i++ {
+			if h[i][j].Sign() == 0 {
+				continue
+			}
+
+			x, y, p, q := eliminator(h[r][j], h[i][j])
+			h.combineRows(r, i, x, y, p, q)
+			u.combineRows(r, i, x, y, p, q)
+		}
+
+		pivot := h[r][j]
+		if pivot.Sign() == 0 {
+			continue
+		}
+
+		if pivot.Sign() < 0 {
+			h.negateRow(r)
+			u.negateRow(r)
+		}
+
+		// Reduce the elements above the pivot into [0, pivot).
+		for i := 0; i < r; i++ {
+			quotient := new(big.Int).Div(h[i][j], pivot)
+			if quotient.Sign() == 0 {
+				continue
+			}
+
+			h.subtractRow(i, r, quotient)
+			u.subtractRow(i, r, quotient)
+		}
+
+		r++
+	}
+
+	d := &Hermite{
+		h: h,
+		u: u,
+	}
+
+	return d, nil
+}
+
+// Return the Hermite normal form "h".
+func (d *Hermite) H() [][]*big.Int {
+	return d.h.copy()
+}
+
+// Return the unimodular matrix "u" satisfying "u * m = h".
+func (d *Hermite) U() [][]*big.Int {
+	return d.u.copy()
+}
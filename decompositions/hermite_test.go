@@ -0,0 +1,73 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewHermite(t *testing.T) {
+	m := dense.New(3, 4)(
+		2, 3, 6, 2,
+		5, 6, 1, 6,
+		8, 3, 1, 1,
+	)
+
+	d, err := NewHermite(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	h := integersFrom(3, 4,
+		1, 0, 50, -11,
+		0, 3, 28, -2,
+		0, 0, 61, -13,
+	)
+
+	if !equalIntegers(d.H(), h) {
+		t.Fatalf("The Hermite normal form should be %v, but is %v.", h, d.H())
+	}
+
+	a, _ := integersOf(m)
+	if !equalIntegers(multiplyIntegers(d.U(), a), h) {
+		t.Fatal("The transformation should satisfy u * m = h.")
+	}
+
+	checkUnimodular(t, d.U())
+}
+
+func TestNewHermiteForDeficientMatrix(t *testing.T) {
+	m := dense.New(3, 3)(
+		0, 2, 4,
+		0, -3, -6,
+		0, 1, 2,
+	)
+
+	d, err := NewHermite(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	h := integersFrom(3, 3,
+		0, 1, 2,
+		0, 0, 0,
+		0, 0, 0,
+	)
+
+	if !equalIntegers(d.H(), h) {
+		t.Fatalf("The Hermite normal form should be %v, but is %v.", h, d.H())
+	}
+
+	a, _ := integersOf(m)
+	if !equalIntegers(multiplyIntegers(d.U(), a), h) {
+		t.Fatal("The transformation should satisfy u * m = h.")
+	}
+
+	checkUnimodular(t, d.U())
+}
+
+func TestNewHermiteFailsForNonIntegralMatrix(t *testing.T) {
+	if _, err := NewHermite(dense.New(1, 2)(1, 1.5)); err == nil {
+		t.Fatal("A non-integral matrix should cause an error.")
+	}
+}
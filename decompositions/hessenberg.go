@@ -0,0 +1,116 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Hessenberg" is the decomposition "m = q * h * q^T" of a square matrix,
+where "h" is upper Hessenberg and "q" is orthogonal.
+*/
+type Hessenberg struct {
+	h *arrays.Array
+	q *arrays.Array
+}
+
+// Reduce the square matrix "m" to upper Hessenberg form with Householder reflections.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func NewHessenberg(m types.Matrix) *Hessenberg {
+	validates.ShapeShouldBeSquare(m)
+
+	h := arrays.Convert(m)
+	q := arrays.Identity(h.Rows())
+	n := h.Rows()
+
+	reflectors := make([]float64, n)
+
+	for k := 1; k < n-1; k++ {
+		scale := 0.0
+		for i := k; i < n; i++ {
+			scale += math.Abs(h.Get(i, k-1))
+		}
+
+		if scale == 0 {
+			continue
+		}
+
+		norm := 0.0
+		for i := n - 1; i >= k; i-- {
+			reflectors[i] = h.Get(i, k-1) / scale
+			norm += reflectors[i] * reflectors[i]
+		}
+
+		g := math.Sqrt(norm)
+		if reflectors[k] > 0 {
+			g = -g
+		}
+
+		norm -= reflectors[k] * g
+		reflectors[k] -= g
+
+		// Apply the reflection "(I - u * u^T / norm)" from the left and the right.
+		for j := k; j < n; j++ {
+			f := 0.0
+			for i := n - 1; i >= k; i-- {
+				f += reflectors[i] * h.Get(i, j)
+			}
+			f /= norm
+
+			for i := k; i < n; i++ {
+				h.Update(i, j, h.Get(i, j)-f*reflectors[i])
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			f := 0.0
+			for j := n - 1; j >= k; j-- {
+				f += reflectors[j] * h.Get(i, j)
+			}
+			f /= norm
+
+			for j := k; j < n; j++ {
+				h.Update(i, j, h.Get(i, j)-f*reflectors[j])
+			}
+		}
+
+		// Accumulate the reflection into "q".
+		for i := 0; i < n; i++ {
+			f := 0.0
+			for j := n - 1; j >= k; j-- {
+				f += reflectors[j] * q.Get(i, j)
+			}
+			f /= norm
+
+			for j := k; j < n; j++ {
+				q.Update(i, j, q.Get(i, j)-f*reflectors[j])
+			}
+		}
+
+		h.Update(k, k-1, scale*g)
+		for i := k + 1; i < n; i++ {
+			h.Update(i, k-1, 0)
+		}
+	}
+
+	d := &Hessenberg{
+		h: h,
+		q: q,
+	}
+
+	return d
+}
+
+// Return the upper Hessenberg matrix "h".
+func (d *Hessenberg) H() *dense.Matrix {
+	return d.h.Matrix()
+}
+
+// Return the orthogonal matrix "q".
+func (d *Hessenberg) Q() *dense.Matrix {
+	return d.q.Matrix()
+}
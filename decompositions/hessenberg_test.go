@@ -0,0 +1,68 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestNewHessenbergReconstructsTheOriginal(t *testing.T) {
+	m := dense.New(4, 4)(
+		4, 1, -2, 2,
+		1, 2, 0, 1,
+		-2, 0, 3, -2,
+		2, 1, -2, -1,
+	)
+
+	d := NewHessenberg(m)
+	h, q := d.H(), d.Q()
+
+	if !equalApproximately(q.Multiply(h).Multiply(q.Transpose()), m, 1e-12) {
+		t.Fatal("The product q * h * q^T should equal to the original matrix.")
+	}
+
+	if !equalApproximately(q.Transpose().Multiply(q), dense.New(4, 4)(
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	), 1e-12) {
+		t.Fatal("The matrix q should be orthogonal.")
+	}
+}
+
+func TestNewHessenbergCreatesUpperHessenberg(t *testing.T) {
+	m := dense.New(5, 5)(
+		1, 2, 3, 4, 5,
+		6, 7, 8, 9, 1,
+		2, 3, 4, 5, 6,
+		7, 8, 9, 1, 2,
+		3, 4, 5, 6, 7,
+	)
+
+	h := NewHessenberg(m).H()
+
+	cursor := h.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if row > column+1 && element != 0 {
+			t.Fatalf("The element at (%d, %d) should be zero, but is %f.", row, column, element)
+		}
+	}
+}
+
+func TestNewHessenbergCausesPanicForNonSquareMatrix(t *testing.T) {
+	m := dense.Zeros(3, 2)
+
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+	NewHessenberg(m)
+}
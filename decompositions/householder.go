@@ -0,0 +1,198 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Householder" is the reflector "h = I - beta * v * v^T", which is symmetric and orthogonal.
+The first element of "v" is 1 unless the reflector is the identity.
+*/
+type Householder struct {
+	v     []float64
+	beta  float64
+	alpha float64
+}
+
+// Create the reflector mapping the column vector "x" to "alpha * e1",
+// where "|alpha| = |x|" and the sign of "alpha" is opposite to the first element of "x" to avoid cancellation.
+// When "x" is zero, the reflector is the identity.
+// When "x" is not a column vector, validates.NOT_VECTOR_PANIC will be caused.
+func NewHouseholder(x types.Matrix) *Householder {
+	validates.ShapeShouldBeColumnVector(x)
+
+	v := columnOf(x)
+	length := normOf(v)
+
+	h := &Householder{
+		v: v,
+	}
+
+	if length == 0 {
+		return h
+	}
+
+	h.alpha = -length
+	if v[0] < 0 {
+		h.alpha = length
+	}
+
+	// "v" is normalized to the first element 1 as LAPACK does,
+	// since "v^T * v" overflows or underflows for vectors of extreme magnitudes.
+	pivot := v[0] - h.alpha
+	v[0] = 1
+	for i := 1; i < len(v); i++ {
+		v[i] /= pivot
+	}
+	h.beta = 2 / dotOf(v, v)
+
+	return h
+}
+
+// Return the first element of "h * x" for the vector "x" given on creation.
+func (h *Householder) Alpha() float64 {
+	return h.alpha
+}
+
+// Return the Householder vector "v" as a column vector.
+func (h *Householder) Vector() *dense.Matrix {
+	return dense.New(len(h.v), 1)(h.v...)
+}
+
+// Return the coefficient "beta".
+func (h *Householder) Beta() float64 {
+	return h.beta
+}
+
+// Overwrite "m" with "h * m".
+// "m" is updated with "(Matrix).Update", so a view of dense matrix is also updated in place.
+// When the number of rows of "m" is different from the size of reflector,
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func (h *Householder) ApplyLeft(m types.Matrix) {
+	if m.Rows() != len(h.v) {
+		panic(validates.NOT_MULTIPLIABLE_PANIC)
+	}
+
+	if h.beta == 0 {
+		return
+	}
+
+	for j := 0; j < m.Columns(); j++ {
+		s := 0.0
+		for i, element := range h.v {
+			s += element * m.Get(i, j)
+		}
+		s *= h.beta
+
+		for i, element := range h.v {
+			m.Update(i, j, m.Get(i, j)-s*element)
+		}
+	}
+}
+
+// Overwrite "m" with "m * h".
+// "m" is updated with "(Matrix).Update", so a view of dense matrix is also updated in place.
+// When the number of columns of "m" is different from the size of reflector,
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func (h *Householder) ApplyRight(m types.Matrix) {
+	if m.Columns() != len(h.v) {
+		panic(validates.NOT_MULTIPLIABLE_PANIC)
+	}
+
+	if h.beta == 0 {
+		return
+	}
+
+	for i := 0; i < m.Rows(); i++ {
+		s := 0.0
+		for j, element := range h.v {
+			s += m.Get(i, j) * element
+		}
+		s *= h.beta
+
+		for j, element := range h.v {
+			m.Update(i, j, m.Get(i, j)-s*element)
+		}
+	}
+}
+
+/*
+"Givens" is the rotation "g" in the plane of the coordinates "i" and "k",
+which is the identity except for "g(i, i) = g(k, k) = c" and "g(i, k) = -g(k, i) = s".
+*/
+type Givens struct {
+	c float64
+	s float64
+	r float64
+	i int
+	k int
+}
+
+// Create the rotation such that "g * x" has "r" at the "i"-th element and zero at the "k"-th one,
+// where "x" is a column vector and "r = sqrt(x(i)^2 + x(k)^2)".
+// When "x" is not a column vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when "i" or "k" is out of range or they are equal, validates.OUT_OF_RANGE_PANIC will be caused.
+func NewGivens(x types.Matrix, i, k int) *Givens {
+	validates.ShapeShouldBeColumnVector(x)
+	validates.IndexShouldBeInRange(x.Rows(), 1, i, 0)
+	validates.IndexShouldBeInRange(x.Rows(), 1, k, 0)
+
+	if i == k {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	a, b := x.Get(i, 0), x.Get(k, 0)
+
+	g := &Givens{
+		c: 1,
+		i: i,
+		k: k,
+	}
+
+	if r := math.Hypot(a, b); r != 0 {
+		g.c, g.s, g.r = a/r, b/r, r
+	}
+
+	return g
+}
+
+// Return the cosine "c" and the sine "s" of the rotation.
+func (g *Givens) CosSin() (c, s float64) {
+	return g.c, g.s
+}
+
+// Return the "i"-th element of "g * x" for the vector "x" given on creation.
+func (g *Givens) R() float64 {
+	return g.r
+}
+
+// Overwrite "m" with "g * m", which changes only the rows "i" and "k".
+// "m" is updated with "(Matrix).Update", so a view of dense matrix is also updated in place.
+// When "m" doesn't have the rows, validates.OUT_OF_RANGE_PANIC will be caused.
+func (g *Givens) ApplyLeft(m types.Matrix) {
+	validates.IndexShouldBeInRange(m.Rows(), m.Columns(), maxInt(g.i, g.k), 0)
+
+	for j := 0; j < m.Columns(); j++ {
+		x, y := m.Get(g.i, j), m.Get(g.k, j)
+		m.Update(g.i, j, g.c*x+g.s*y)
+		m.Update(g.k, j, -g.s*x+g.c*y)
+	}
+}
+
+// Overwrite "m" with "m * g^T", which changes only the columns "i" and "k".
+// Applying "ApplyLeft" and "ApplyRight" to a square matrix results in the similarity transformation.
+// "m" is updated with "(Matrix).Update", so a view of dense matrix is also updated in place.
+// When "m" doesn't have the columns, validates.OUT_OF_RANGE_PANIC will be caused.
+func (g *Givens) ApplyRight(m types.Matrix) {
+	validates.IndexShouldBeInRange(m.Rows(), m.Columns(), 0, maxInt(g.i, g.k))
+
+	for i := 0; i < m.Rows(); i++ {
+		x, y := m.Get(i, g.i), m.Get(i, g.k)
+		m.Update(i, g.i, g.c*x+g.s*y)
+		m.Update(i, g.k, -g.s*x+g.c*y)
+	}
+}
@@ -0,0 +1,144 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestHouseholderMapsVectorToAxis(t *testing.T) {
+	x := dense.New(3, 1)(3, 4, 0)
+
+	h := NewHouseholder(x)
+	h.ApplyLeft(x)
+
+	if !equalApproximately(x, dense.New(3, 1)(-5, 0, 0), 1e-12) || h.Alpha() != -5 {
+		t.Fatal("The reflector should map the vector to alpha * e1.")
+	}
+
+	// The reflector is orthogonal and symmetric, so it is an involution.
+	m := dense.New(3, 2)(
+		1, 2,
+		3, 4,
+		5, 6,
+	)
+	h.ApplyLeft(m)
+	h.ApplyLeft(m)
+
+	if !equalApproximately(m, dense.New(3, 2)(1, 2, 3, 4, 5, 6), 1e-12) {
+		t.Fatal("Applying the reflector twice should be the identity.")
+	}
+}
+
+func TestHouseholderAppliesToView(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		4, 1, 0,
+		0, 3, 2,
+	)
+
+	// Reduce the first column of the lower-right block.
+	view := m.View(1, 1, 2, 2)
+	h := NewHouseholder(view.Column(0))
+	h.ApplyLeft(view)
+
+	if math.Abs(m.Get(2, 1)) > 1e-12 || math.Abs(m.Get(1, 1)-h.Alpha()) > 1e-12 {
+		t.Fatal("The reflector should update the view in place.")
+	}
+
+	if m.Get(0, 0) != 1 || m.Get(1, 0) != 4 || m.Get(0, 2) != 3 {
+		t.Fatal("The elements out of the view should be kept.")
+	}
+
+	h.ApplyRight(view)
+
+	if m.Get(0, 1) != 2 || m.Get(0, 2) != 3 || m.Get(2, 0) != 0 {
+		t.Fatal("The elements out of the view should be kept.")
+	}
+}
+
+func TestHouseholderAtExtremeMagnitudes(t *testing.T) {
+	x := dense.New(2, 1)(1e200, 1e200)
+
+	h := NewHouseholder(x)
+	h.ApplyLeft(x)
+
+	if math.Abs(h.Alpha()/1e200+math.Sqrt2) > 1e-12 || math.Abs(x.Get(0, 0)/1e200+math.Sqrt2) > 1e-12 {
+		t.Fatalf("The reflector should map a huge vector to alpha * e1, but alpha is %v.", h.Alpha())
+	}
+
+	if math.Abs(x.Get(1, 0)) > 1e188 {
+		t.Fatal("The reflector should zero the second element of a huge vector.")
+	}
+
+	y := dense.New(2, 1)(1e-200, 0)
+	if h := NewHouseholder(y); h.Alpha() != -1e-200 {
+		t.Fatalf("Alpha should be -1e-200 for a tiny vector, but is %v.", h.Alpha())
+	}
+
+	z := dense.New(2, 1)(1e-200, 1e-200)
+	h = NewHouseholder(z)
+	h.ApplyLeft(z)
+
+	if math.Abs(z.Get(0, 0)/1e-200+math.Sqrt2) > 1e-12 || math.Abs(z.Get(1, 0)) > 1e-212 {
+		t.Fatal("The reflector should map a tiny vector to alpha * e1.")
+	}
+}
+
+func TestHouseholderCausesPanicForDifferentSize(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_MULTIPLIABLE_PANIC {
+			t.Fatalf("A matrix of different size should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+		}
+	}()
+
+	NewHouseholder(dense.New(2, 1)(1, 1)).ApplyRight(dense.Zeros(2, 3))
+}
+
+func TestGivensZeroesElement(t *testing.T) {
+	x := dense.New(3, 1)(3, 1, 4)
+
+	g := NewGivens(x, 0, 2)
+	g.ApplyLeft(x)
+
+	if !equalApproximately(x, dense.New(3, 1)(5, 1, 0), 1e-12) || g.R() != 5 {
+		t.Fatal("The rotation should zero the k-th element.")
+	}
+
+	c, s := g.CosSin()
+	if math.Abs(c*c+s*s-1) > 1e-15 {
+		t.Fatal("The cosine and sine should satisfy c^2 + s^2 = 1.")
+	}
+}
+
+func TestGivensSimilarityKeepsTrace(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 0,
+		3, 4, 5,
+		6, 0, 7,
+	)
+
+	g := NewGivens(m.Column(0), 1, 2)
+	g.ApplyLeft(m)
+	g.ApplyRight(m)
+
+	if math.Abs(m.Get(2, 0)) > 1e-12 {
+		t.Fatal("The rotation should zero the element of the first column.")
+	}
+
+	if math.Abs(m.Get(0, 0)+m.Get(1, 1)+m.Get(2, 2)-12) > 1e-12 {
+		t.Fatal("The similarity transformation should keep the trace.")
+	}
+}
+
+func TestNewGivensCausesPanicForSameIndices(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("The same indices should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	NewGivens(dense.New(2, 1)(1, 1), 1, 1)
+}
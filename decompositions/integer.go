@@ -0,0 +1,179 @@
+package decompositions
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"NonIntegralError" is returned when an integer decomposition is applied to a matrix
+which has an element not representing an integer exactly.
+*/
+type NonIntegralError struct {
+	Row     int
+	Column  int
+	Element float64
+}
+
+func (e *NonIntegralError) Error() string {
+	return fmt.Sprintf(
+		"NonIntegralError: the element %v at (%d, %d) is not an integer",
+		e.Element,
+		e.Row,
+		e.Column,
+	)
+}
+
+// "integers" is a matrix of arbitrary-precision integers in row-major order.
+type integers [][]*big.Int
+
+// Convert "m" into integers after checking that all the elements are integral.
+func integersOf(m types.Matrix) (integers, error) {
+	rows, columns := m.Shape()
+	a := zeroIntegers(rows, columns)
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if math.IsInf(element, 0) || math.IsNaN(element) || math.Trunc(element) != element {
+			return nil, &NonIntegralError{Row: row, Column: column, Element: element}
+		}
+
+		big.NewFloat(element).Int(a[row][column])
+	}
+
+	return a, nil
+}
+
+func zeroIntegers(rows, columns int) integers {
+	a := make(integers, rows)
+
+	for i := range a {
+		a[i] = make([]*big.Int, columns)
+		for j := range a[i] {
+			a[i][j] = new(big.Int)
+		}
+	}
+
+	return a
+}
+
+func identityIntegers(size int) integers {
+	a := zeroIntegers(size, size)
+
+	for i := range a {
+		a[i][i].SetInt64(1)
+	}
+
+	return a
+}
+
+// Return a deep copy of the receiver.
+func (a integers) copy() integers {
+	b := make(integers, len(a))
+
+	for i, row := range a {
+		b[i] = make([]*big.Int, len(row))
+		for j, element := range row {
+			b[i][j] = new(big.Int).Set(element)
+		}
+	}
+
+	return b
+}
+
+// Replace the "i"-th and "k"-th rows with "x * a_i + y * a_k" and "p * a_i + q * a_k".
+func (a integers) combineRows(i, k int, x, y, p, q *big.Int) {
+	s, t := new(big.Int), new(big.Int)
+
+	for j := range a[i] {
+		s.Mul(x, a[i][j])
+		t.Mul(y, a[k][j])
+		s.Add(s, t)
+
+		t.Mul(p, a[i][j])
+		a[k][j].Mul(q, a[k][j])
+		a[k][j].Add(a[k][j], t)
+
+		a[i][j].Set(s)
+	}
+}
+
+// Replace the "j"-th and "k"-th columns with "x * a_j + y * a_k" and "p * a_j + q * a_k".
+func (a integers) combineColumns(j, k int, x, y, p, q *big.Int) {
+	s, t := new(big.Int), new(big.Int)
+
+	for i := range a {
+		s.Mul(x, a[i][j])
+		t.Mul(y, a[i][k])
+		s.Add(s, t)
+
+		t.Mul(p, a[i][j])
+		a[i][k].Mul(q, a[i][k])
+		a[i][k].Add(a[i][k], t)
+
+		a[i][j].Set(s)
+	}
+}
+
+// Subtract "q" times the "k"-th row from the "i"-th row.
+func (a integers) subtractRow(i, k int, q *big.Int) {
+	t := new(big.Int)
+
+	for j := range a[i] {
+		a[i][j].Sub(a[i][j], t.Mul(q, a[k][j]))
+	}
+}
+
+func (a integers) negateRow(i int) {
+	for _, element := range a[i] {
+		element.Neg(element)
+	}
+}
+
+func (a integers) swapRows(i, k int) {
+	a[i], a[k] = a[k], a[i]
+}
+
+func (a integers) swapColumns(j, k int) {
+	for _, row := range a {
+		row[j], row[k] = row[k], row[j]
+	}
+}
+
+// Return the unimodular transformation "[[x, y], [p, q]]"
+// which maps the pair "(a, b)" to "(gcd(a, b), 0)".
+// The gcd is non-negative, and the transformation is the identity when "b" is zero and "a" is non-negative.
+func eliminator(a, b *big.Int) (x, y, p, q *big.Int) {
+	// Maintain "r0 = x0 * a + y0 * b" and "r1 = x1 * a + y1 * b" in the Euclidean algorithm.
+	r0, r1 := new(big.Int).Set(a), new(big.Int).Set(b)
+	x0, y0 := big.NewInt(1), big.NewInt(0)
+	x1, y1 := big.NewInt(0), big.NewInt(1)
+
+	for r1.Sign() != 0 {
+		quotient := new(big.Int).Quo(r0, r1)
+
+		r0, r1 = r1, new(big.Int).Sub(r0, new(big.Int).Mul(quotient, r1))
+		x0, x1 = x1, new(big.Int).Sub(x0, new(big.Int).Mul(quotient, x1))
+		y0, y1 = y1, new(big.Int).Sub(y0, new(big.Int).Mul(quotient, y1))
+	}
+
+	if r0.Sign() < 0 {
+		x0.Neg(x0)
+		y0.Neg(y0)
+	}
+
+	// The second row "(x1, y1)" is "(-b, a) / gcd" up to sign,
+	// and the sign is chosen so that the determinant is one.
+	if new(big.Int).Sub(new(big.Int).Mul(x0, y1), new(big.Int).Mul(y0, x1)).Sign() < 0 {
+		x1.Neg(x1)
+		y1.Neg(y1)
+	}
+
+	return x0, y0, x1, y1
+}
@@ -0,0 +1,129 @@
+package decompositions
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func multiplyIntegers(a, b [][]*big.Int) [][]*big.Int {
+	c := zeroIntegers(len(a), len(b[0]))
+	t := new(big.Int)
+
+	for i := range a {
+		for k := range b {
+			for j := range b[k] {
+				c[i][j].Add(c[i][j], t.Mul(a[i][k], b[k][j]))
+			}
+		}
+	}
+
+	return c
+}
+
+func equalIntegers(a, b [][]*big.Int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+
+		for j := range a[i] {
+			if a[i][j].Cmp(b[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func integersFrom(rows, columns int, elements ...int64) [][]*big.Int {
+	a := zeroIntegers(rows, columns)
+
+	for i := range a {
+		for j := range a[i] {
+			a[i][j].SetInt64(elements[i*columns+j])
+		}
+	}
+
+	return a
+}
+
+// Compute the determinant of the square integer matrix "a" with rational elimination.
+func determinantOfIntegers(a [][]*big.Int) *big.Rat {
+	n := len(a)
+	r := make([][]*big.Rat, n)
+	for i := range r {
+		r[i] = make([]*big.Rat, n)
+		for j := range r[i] {
+			r[i][j] = new(big.Rat).SetInt(a[i][j])
+		}
+	}
+
+	det := big.NewRat(1, 1)
+
+	for k := 0; k < n; k++ {
+		p := k
+		for p < n && r[p][k].Sign() == 0 {
+			p++
+		}
+
+		if p == n {
+			return new(big.Rat)
+		}
+
+		if p != k {
+			r[p], r[k] = r[k], r[p]
+			det.Neg(det)
+		}
+		det.Mul(det, r[k][k])
+
+		for i := k + 1; i < n; i++ {
+			factor := new(big.Rat).Quo(r[i][k], r[k][k])
+			for j := k; j < n; j++ {
+				r[i][j].Sub(r[i][j], new(big.Rat).Mul(factor, r[k][j]))
+			}
+		}
+	}
+
+	return det
+}
+
+func checkUnimodular(t *testing.T, u [][]*big.Int) {
+	if det := determinantOfIntegers(u); det.Cmp(big.NewRat(1, 1)) != 0 && det.Cmp(big.NewRat(-1, 1)) != 0 {
+		t.Fatalf("The transformation should be unimodular, but the determinant is %s.", det)
+	}
+}
+
+func TestIntegersOfFailsForNonIntegralElement(t *testing.T) {
+	for _, element := range []float64{0.5, math.Inf(1), math.NaN()} {
+		m := dense.New(2, 2)(
+			1, 2,
+			3, element,
+		)
+
+		_, err := integersOf(m)
+
+		e, ok := err.(*NonIntegralError)
+		if !ok || e.Row != 1 || e.Column != 1 {
+			t.Fatal("A non-integral element should cause NonIntegralError with the position.")
+		}
+	}
+}
+
+func TestIntegersOfConvertsLargeIntegerExactly(t *testing.T) {
+	a, err := integersOf(dense.New(1, 1)(math.Pow(2, 60)))
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if a[0][0].Cmp(new(big.Int).Lsh(big.NewInt(1), 60)) != 0 {
+		t.Fatal("A large integer should be converted exactly.")
+	}
+}
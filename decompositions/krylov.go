@@ -0,0 +1,643 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	defaultKrylovTolerance = 1e-10
+	defaultKrylovRestarts  = 300
+	minimumKrylovSubspace  = 20
+)
+
+/*
+"Which" specifies the part of spectrum computed by a Krylov subspace method.
+For a symmetric matrix, the real part is the eigenvalue itself.
+*/
+type Which int
+
+const (
+	// The eigenvalues with the largest absolute values.
+	LargestMagnitude Which = iota
+
+	// The eigenvalues with the smallest absolute values.
+	SmallestMagnitude
+
+	// The eigenvalues with the largest real parts.
+	LargestReal
+
+	// The eigenvalues with the smallest real parts.
+	SmallestReal
+)
+
+/*
+"KrylovSettings" controls a Krylov subspace method.
+The zero value is available,
+where the tolerance is 1e-10, the maximum number of restarts is 300,
+the dimension of subspace is the larger of "2 * k + 1" and 20,
+and the starting vector is generated with a fixed seed.
+*/
+type KrylovSettings struct {
+	// The tolerance for the residual estimates relative to the largest absolute Ritz value.
+	Tolerance float64
+
+	// The maximum number of implicit restarts.
+	MaxRestarts int
+
+	// The dimension of Krylov subspace, which is limited to the size of matrix.
+	Subspace int
+
+	// The starting vector as a column vector.
+	Initial types.Matrix
+}
+
+// "krylov" is the Arnoldi factorization "a * v = v * h + f * e^T" of length "m"
+// for a linear operator "a", where "v" is the orthonormal basis of Krylov subspace,
+// "h" is the "m x m" upper Hessenberg matrix and "f" is the residual vector.
+// When the operator is symmetric, "h" is kept tridiagonal as the Lanczos factorization.
+type krylov struct {
+	operator  func(x []float64) []float64
+	symmetric bool
+	size      int
+	subspace  int
+	v         [][]float64
+	h         *arrays.Array
+	f         []float64
+	random    *rand.Rand
+}
+
+// Prepare the factorization of the operator on vectors of length "size" to compute "k" eigenpairs,
+// and return the tolerance and the maximum number of restarts.
+// When "k" is not in [1, size], validates.OUT_OF_RANGE_PANIC will be caused.
+func newKrylov(
+	operator func(x []float64) []float64,
+	size, k int,
+	symmetric bool,
+	s *KrylovSettings,
+) (kr *krylov, tolerance float64, restarts int) {
+	if k < 1 || k > size {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	if s == nil {
+		s = &KrylovSettings{}
+	}
+
+	tolerance = s.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultKrylovTolerance
+	}
+
+	restarts = s.MaxRestarts
+	if restarts <= 0 {
+		restarts = defaultKrylovRestarts
+	}
+
+	subspace := s.Subspace
+	if subspace <= 0 {
+		subspace = maxInt(2*k+1, minimumKrylovSubspace)
+	}
+	subspace = minInt(maxInt(subspace, k+1), size)
+
+	kr = &krylov{
+		operator:  operator,
+		symmetric: symmetric,
+		size:      size,
+		subspace:  subspace,
+		v:         make([][]float64, 0, subspace),
+		h:         arrays.Zeros(subspace, subspace),
+		random:    rand.New(rand.NewSource(1)),
+	}
+
+	if s.Initial == nil {
+		kr.f = kr.randomVector()
+	} else {
+		validates.ShapeShouldBeColumnVector(s.Initial)
+		if s.Initial.Rows() != size {
+			panic(validates.DIFFERENT_SIZE_PANIC)
+		}
+
+		kr.f = columnOf(s.Initial)
+		if normOf(kr.f) == 0 {
+			kr.f = kr.randomVector()
+		}
+	}
+
+	return kr, tolerance, restarts
+}
+
+// Extend the factorization from length "from" to the dimension of subspace.
+// When an invariant subspace is found, the basis is continued with a random vector.
+func (kr *krylov) expand(from int) {
+	for j := from; j < kr.subspace; j++ {
+		beta := normOf(kr.f)
+
+		if j > 0 && beta <= arrays.Epsilon*kr.scale(j-1) {
+			beta = 0
+			kr.f = kr.randomVector()
+			kr.orthogonalize(kr.f)
+		}
+
+		if j > 0 {
+			kr.h.Update(j, j-1, beta)
+		}
+
+		length := normOf(kr.f)
+		basis := make([]float64, kr.size)
+		for i, element := range kr.f {
+			basis[i] = element / length
+		}
+		kr.v = append(kr.v, basis)
+
+		w := kr.operator(basis)
+		coefficients := kr.orthogonalize(w)
+
+		if kr.symmetric {
+			kr.h.Update(j, j, coefficients[j])
+			if j > 0 {
+				kr.h.Update(j-1, j, kr.h.Get(j, j-1))
+			}
+		} else {
+			for i, c := range coefficients {
+				kr.h.Update(i, j, c)
+			}
+		}
+
+		kr.f = w
+	}
+}
+
+// Return the scale of the "j"-th column of "h" used to detect an invariant subspace.
+func (kr *krylov) scale(j int) float64 {
+	s := 0.0
+
+	for i := 0; i <= minInt(j+1, kr.subspace-1); i++ {
+		s = math.Hypot(s, kr.h.Get(i, j))
+	}
+
+	if s == 0 {
+		return 1
+	}
+
+	return s
+}
+
+// Orthogonalize "w" against the basis in place with the classical Gram-Schmidt process
+// applied twice, and return the coefficients.
+func (kr *krylov) orthogonalize(w []float64) []float64 {
+	coefficients := make([]float64, len(kr.v))
+	d := make([]float64, len(kr.v))
+
+	for pass := 0; pass < 2; pass++ {
+		for i, v := range kr.v {
+			d[i] = dotOf(v, w)
+			coefficients[i] += d[i]
+		}
+
+		for i, v := range kr.v {
+			for l, element := range v {
+				w[l] -= d[i] * element
+			}
+		}
+	}
+
+	return coefficients
+}
+
+func (kr *krylov) randomVector() []float64 {
+	x := make([]float64, kr.size)
+
+	for i := range x {
+		x[i] = kr.random.NormFloat64()
+	}
+
+	return x
+}
+
+// "ritz" is the set of Ritz pairs of the current factorization.
+// The vectors are the eigenvectors of "h" with unit length,
+// so the Ritz vectors are obtained by multiplying the basis.
+type ritz struct {
+	values    []complex128
+	real      *arrays.Array
+	imaginary *arrays.Array
+	residuals []float64
+}
+
+// Compute the Ritz pairs and their residual estimates "|a * x - value * x|".
+func (kr *krylov) ritz() (*ritz, error) {
+	m := kr.subspace
+	beta := normOf(kr.f)
+
+	r := &ritz{
+		values:    make([]complex128, m),
+		residuals: make([]float64, m),
+	}
+
+	if kr.symmetric {
+		d := make([]float64, m)
+		e := make([]float64, m)
+		for i := 0; i < m; i++ {
+			d[i] = kr.h.Get(i, i)
+			if i > 0 {
+				e[i] = kr.h.Get(i, i-1)
+			}
+		}
+
+		v := arrays.Identity(m)
+		if err := diagonalize(v, d, e); err != nil {
+			return nil, err
+		}
+
+		for i := range d {
+			r.values[i] = complex(d[i], 0)
+			r.residuals[i] = beta * math.Abs(v.Get(m-1, i))
+		}
+
+		r.real = v
+		r.imaginary = arrays.Zeros(m, m)
+
+		return r, nil
+	}
+
+	t := kr.h.Copy()
+	z := arrays.Identity(m)
+
+	values, err := francis(t, z)
+	if err != nil {
+		return nil, err
+	}
+
+	r.values = values
+	r.real, r.imaginary = schurVectors(&Schur{t: t, z: z, values: values})
+
+	for i := range values {
+		r.residuals[i] = beta * math.Hypot(r.real.Get(m-1, i), r.imaginary.Get(m-1, i))
+	}
+
+	return r, nil
+}
+
+// Apply the shifted QR iterations to "h" with the unwanted Ritz values "shifts",
+// and truncate the factorization to length "keep".
+// A pair of complex conjugate shifts is applied at once in real arithmetic
+// and represented by the one with positive imaginary part.
+func (kr *krylov) restart(shifts []complex128, keep int) {
+	m := kr.subspace
+	q := arrays.Identity(m)
+
+	for _, shift := range shifts {
+		var p *arrays.Array
+
+		switch {
+		case imag(shift) == 0:
+			p = kr.h.Copy()
+			for i := 0; i < m; i++ {
+				p.Update(i, i, p.Get(i, i)-real(shift))
+			}
+		case imag(shift) > 0:
+			p = kr.h.Multiply(kr.h)
+			s := 2 * real(shift)
+			t := real(shift)*real(shift) + imag(shift)*imag(shift)
+			for i := 0; i < m; i++ {
+				for j := 0; j < m; j++ {
+					p.Update(i, j, p.Get(i, j)-s*kr.h.Get(i, j))
+				}
+				p.Update(i, i, p.Get(i, i)+t)
+			}
+		default:
+			continue
+		}
+
+		qs := orthogonalFactor(p)
+		kr.h = qs.Transpose().Multiply(kr.h).Multiply(qs)
+		q = q.Multiply(qs)
+		kr.clean()
+	}
+
+	// Update the residual vector and the basis.
+	beta := kr.h.Get(keep, keep-1)
+	sigma := q.Get(m-1, keep-1)
+
+	f := make([]float64, kr.size)
+	for l := range f {
+		f[l] = sigma * kr.f[l]
+	}
+
+	v := make([][]float64, keep, m)
+	for j := 0; j <= keep; j++ {
+		column := make([]float64, kr.size)
+		for i, basis := range kr.v {
+			c := q.Get(i, j)
+			if c == 0 {
+				continue
+			}
+			for l, element := range basis {
+				column[l] += c * element
+			}
+		}
+
+		if j < keep {
+			v[j] = column
+		} else {
+			for l, element := range column {
+				f[l] += beta * element
+			}
+		}
+	}
+
+	h := arrays.Zeros(m, m)
+	for i := 0; i < keep; i++ {
+		for j := 0; j < keep; j++ {
+			h.Update(i, j, kr.h.Get(i, j))
+		}
+	}
+
+	kr.v = v
+	kr.h = h
+	kr.f = f
+}
+
+// Remove the rounding errors which break the structure of "h".
+func (kr *krylov) clean() {
+	m := kr.subspace
+
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			switch {
+			case i > j+1:
+				kr.h.Update(i, j, 0)
+			case kr.symmetric && j > i+1:
+				kr.h.Update(i, j, 0)
+			case kr.symmetric && j == i+1:
+				average := (kr.h.Get(i, j) + kr.h.Get(j, i)) / 2
+				kr.h.Update(i, j, average)
+				kr.h.Update(j, i, average)
+			}
+		}
+	}
+}
+
+// Run the implicitly restarted iteration until the "k" wanted Ritz pairs converge,
+// and return the Ritz pairs with the indices of wanted ones in the order of preference.
+func (kr *krylov) iterate(k int, which Which, tolerance float64, restarts int) (*ritz, []int, error) {
+	kr.expand(0)
+
+	for restart := 0; ; restart++ {
+		r, err := kr.ritz()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		order := sortRitz(r.values, which)
+
+		scale := 0.0
+		for _, value := range r.values {
+			scale = math.Max(scale, cmplx.Abs(value))
+		}
+
+		converged := 0
+		for _, i := range order[:k] {
+			if r.residuals[i] <= tolerance*scale {
+				converged++
+			}
+		}
+
+		if converged == k {
+			return r, order[:k], nil
+		}
+
+		// Keep some more vectors than wanted to avoid stagnation, and never split a conjugate pair.
+		keep := k + minInt(converged, (kr.subspace-k)/2)
+		if !kr.symmetric && keep < kr.subspace && imag(r.values[order[keep-1]]) > 0 {
+			keep++
+		}
+
+		if restart == restarts || keep >= kr.subspace {
+			return nil, nil, errors.New(NotConvergedError)
+		}
+
+		shifts := make([]complex128, 0, kr.subspace-keep)
+		for _, i := range order[keep:] {
+			shifts = append(shifts, r.values[i])
+		}
+
+		kr.restart(shifts, keep)
+		kr.expand(keep)
+	}
+}
+
+// Compute the Ritz vectors "v * y" for the columns "indices" of "y".
+func (kr *krylov) vectors(y *arrays.Array, indices []int) *arrays.Array {
+	x := arrays.Zeros(kr.size, len(indices))
+
+	for k, index := range indices {
+		for i, basis := range kr.v {
+			c := y.Get(i, index)
+			if c == 0 {
+				continue
+			}
+			for l, element := range basis {
+				x.Update(l, k, x.Get(l, k)+c*element)
+			}
+		}
+	}
+
+	return x
+}
+
+// Return the indices of "values" sorted in the order of preference specified by "which".
+// A pair of complex conjugate values is adjacent and the one with positive imaginary part comes first.
+func sortRitz(values []complex128, which Which) []int {
+	keys := make([]float64, len(values))
+
+	for i, value := range values {
+		switch which {
+		case LargestMagnitude:
+			keys[i] = cmplx.Abs(value)
+		case SmallestMagnitude:
+			keys[i] = -cmplx.Abs(value)
+		case LargestReal:
+			keys[i] = real(value)
+		case SmallestReal:
+			keys[i] = -real(value)
+		}
+	}
+
+	b := &byPreference{
+		values: values,
+		keys:   keys,
+		order:  make([]int, len(values)),
+	}
+	for i := range b.order {
+		b.order[i] = i
+	}
+
+	sort.Sort(b)
+
+	return b.order
+}
+
+type byPreference struct {
+	values []complex128
+	keys   []float64
+	order  []int
+}
+
+func (b *byPreference) Len() int {
+	return len(b.order)
+}
+
+func (b *byPreference) Less(i, j int) bool {
+	x, y := b.order[i], b.order[j]
+
+	if b.keys[x] != b.keys[y] {
+		return b.keys[x] > b.keys[y]
+	}
+
+	if real(b.values[x]) != real(b.values[y]) {
+		return real(b.values[x]) > real(b.values[y])
+	}
+
+	if a, c := math.Abs(imag(b.values[x])), math.Abs(imag(b.values[y])); a != c {
+		return a > c
+	}
+
+	return imag(b.values[x]) > imag(b.values[y])
+}
+
+func (b *byPreference) Swap(i, j int) {
+	b.order[i], b.order[j] = b.order[j], b.order[i]
+}
+
+// Return the orthogonal factor "q" of the QR decomposition "a = q * r"
+// computed with Householder reflections.
+func orthogonalFactor(a *arrays.Array) *arrays.Array {
+	r := a.Copy()
+	n := r.Rows()
+	q := arrays.Identity(n)
+
+	u := make([]float64, n)
+
+	for k := 0; k < n-1; k++ {
+		alpha := 0.0
+		for i := k; i < n; i++ {
+			alpha = math.Hypot(alpha, r.Get(i, k))
+		}
+
+		if alpha == 0 {
+			continue
+		}
+
+		if r.Get(k, k) > 0 {
+			alpha = -alpha
+		}
+
+		for i := k; i < n; i++ {
+			u[i] = r.Get(i, k)
+		}
+		u[k] -= alpha
+
+		uu := 0.0
+		for i := k; i < n; i++ {
+			uu += u[i] * u[i]
+		}
+
+		// Apply "I - 2 * u * u^T / (u^T * u)" to "r" from the left and to "q" from the right.
+		for j := k; j < n; j++ {
+			s := 0.0
+			for i := k; i < n; i++ {
+				s += u[i] * r.Get(i, j)
+			}
+			s *= 2 / uu
+			for i := k; i < n; i++ {
+				r.Update(i, j, r.Get(i, j)-s*u[i])
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			s := 0.0
+			for l := k; l < n; l++ {
+				s += q.Get(i, l) * u[l]
+			}
+			s *= 2 / uu
+			for l := k; l < n; l++ {
+				q.Update(i, l, q.Get(i, l)-s*u[l])
+			}
+		}
+	}
+
+	return q
+}
+
+// Compute "m * x" through "(Matrix).Multiply".
+func multiplyVector(m types.Matrix, x []float64) []float64 {
+	return columnOf(m.Multiply(dense.New(len(x), 1)(x...)))
+}
+
+// Copy the elements of the column vector "v" into a slice.
+func columnOf(v types.Matrix) []float64 {
+	x := make([]float64, v.Rows())
+
+	cursor := v.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, _ := cursor.Get()
+		x[row] = element
+	}
+
+	return x
+}
+
+func dotOf(x, y []float64) float64 {
+	s := 0.0
+
+	for i := range x {
+		s += x[i] * y[i]
+	}
+
+	return s
+}
+
+// Return the Euclidean norm of "x".
+// The squares are scaled by the largest absolute value to avoid overflow and underflow.
+func normOf(x []float64) float64 {
+	scale, squares, infinite := 0.0, 0.0, false
+
+	for _, element := range x {
+		switch {
+		case element == 0:
+			continue
+		case math.IsInf(element, 0):
+			infinite = true
+			continue
+		}
+
+		a := math.Abs(element)
+
+		if a > scale {
+			r := scale / a
+			squares = 1 + squares*r*r
+			scale = a
+		} else {
+			r := a / scale
+			squares += r * r
+		}
+	}
+
+	norm := scale * math.Sqrt(squares)
+	if infinite && !math.IsNaN(norm) {
+		return math.Inf(1)
+	}
+
+	return norm
+}
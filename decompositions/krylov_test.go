@@ -0,0 +1,92 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create the Laplacian of the path graph with "n" vertices.
+func pathLaplacian(n int) *dense.Matrix {
+	m := dense.Zeros(n, n)
+
+	for i := 0; i < n; i++ {
+		degree := 0.0
+
+		if i > 0 {
+			m.Update(i, i-1, -1)
+			degree++
+		}
+
+		if i < n-1 {
+			m.Update(i, i+1, -1)
+			degree++
+		}
+
+		m.Update(i, i, degree)
+	}
+
+	return m
+}
+
+func TestSortRitzKeepsConjugatePairsAdjacent(t *testing.T) {
+	values := []complex128{1 - 2i, 3, -1, 1 + 2i, 2 - 2i, 2 + 2i}
+
+	order := sortRitz(values, LargestMagnitude)
+	expected := []int{1, 5, 4, 3, 0, 2}
+
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("The order should be %v, but is %v.", expected, order)
+		}
+	}
+
+	order = sortRitz(values, SmallestReal)
+	expected = []int{2, 3, 0, 5, 4, 1}
+
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("The order should be %v, but is %v.", expected, order)
+		}
+	}
+}
+
+func TestOrthogonalFactorCreatesOrthogonalMatrix(t *testing.T) {
+	a := arrays.Convert(dense.New(3, 3)(
+		2, -1, 3,
+		4, 1, 0,
+		0, 5, -2,
+	))
+
+	q := orthogonalFactor(a)
+
+	if !equalApproximately(q.Transpose().Multiply(q).Matrix(), arrays.Identity(3).Matrix(), 1e-12) {
+		t.Fatal("The factor q should be orthogonal.")
+	}
+
+	// "q^T * a" should be upper triangular.
+	r := q.Transpose().Multiply(a)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < i; j++ {
+			if r.Get(i, j) > 1e-12 || r.Get(i, j) < -1e-12 {
+				t.Fatal("The product q^T * a should be upper triangular.")
+			}
+		}
+	}
+}
+
+func TestKrylovCausesPanicForInvalidCount(t *testing.T) {
+	for _, k := range []int{0, 5} {
+		func() {
+			defer func() {
+				if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+					t.Fatalf("The count %d should cause %s.", k, validates.OUT_OF_RANGE_PANIC)
+				}
+			}()
+
+			NewLanczos(pathLaplacian(4), k, LargestReal, nil)
+		}()
+	}
+}
@@ -0,0 +1,84 @@
+package decompositions
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Lanczos" is the set of "k" eigenpairs of a symmetric matrix
+computed with the implicitly restarted Lanczos method.
+The matrix is accessed only through "(Matrix).Multiply",
+so this is suitable for a large matrix whose full decomposition is too expensive.
+*/
+type Lanczos struct {
+	values    []float64
+	vectors   *arrays.Array
+	residuals []float64
+}
+
+// Compute the "k" eigenpairs of the symmetric matrix "m" specified by "which".
+// The Lanczos vectors are fully reorthogonalized.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "k" is not in [1, m.Rows()], validates.OUT_OF_RANGE_PANIC will be caused.
+// When the iteration doesn't converge within the maximum number of restarts, an error will be returned.
+func NewLanczos(m types.Matrix, k int, which Which, s *KrylovSettings) (*Lanczos, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	operator := func(x []float64) []float64 {
+		return multiplyVector(m, x)
+	}
+
+	return newLanczos(operator, m.Rows(), k, which, s)
+}
+
+func newLanczos(
+	operator func(x []float64) []float64,
+	size, k int,
+	which Which,
+	s *KrylovSettings,
+) (*Lanczos, error) {
+	kr, tolerance, restarts := newKrylov(operator, size, k, true, s)
+
+	r, indices, err := kr.iterate(k, which, tolerance, restarts)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Lanczos{
+		values:    make([]float64, k),
+		vectors:   kr.vectors(r.real, indices),
+		residuals: make([]float64, k),
+	}
+
+	for i, index := range indices {
+		l.values[i] = real(r.values[index])
+		l.residuals[i] = r.residuals[index]
+	}
+
+	return l, nil
+}
+
+// Return the eigenvalues in the order of preference specified by "Which".
+func (l *Lanczos) Values() []float64 {
+	values := make([]float64, len(l.values))
+	copy(values, l.values)
+
+	return values
+}
+
+// Return the matrix whose k-th column is the eigenvector with unit length
+// for the k-th eigenvalue of "(*Lanczos).Values".
+func (l *Lanczos) Vectors() *dense.Matrix {
+	return l.vectors.Matrix()
+}
+
+// Return the estimates of residual norm "|m * x - value * x|" for the eigenpairs.
+func (l *Lanczos) Residuals() []float64 {
+	residuals := make([]float64, len(l.residuals))
+	copy(residuals, l.residuals)
+
+	return residuals
+}
@@ -0,0 +1,93 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewLanczosComputesSmallestEigenpairsOfLaplacian(t *testing.T) {
+	n := 100
+	m := pathLaplacian(n)
+
+	l, err := NewLanczos(m, 4, SmallestReal, &KrylovSettings{MaxRestarts: 1000})
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	// The eigenvalues of the path graph are "2 - 2 * cos(pi * k / n)".
+	for k, value := range l.Values() {
+		expected := 2 - 2*math.Cos(math.Pi*float64(k)/float64(n))
+		if math.Abs(value-expected) > 1e-8 {
+			t.Fatalf("The %d-th eigenvalue should be %v, but is %v.", k, expected, value)
+		}
+	}
+
+	checkSymmetricEigenpairs(t, m, l.Values(), l.Vectors(), l.Residuals())
+}
+
+func TestNewLanczosComputesLargestEigenpairs(t *testing.T) {
+	m := dense.New(5, 5)(
+		4, 1, -2, 2, 0,
+		1, 2, 0, 1, 1,
+		-2, 0, 3, -2, 0,
+		2, 1, -2, -1, 3,
+		0, 1, 0, 3, 5,
+	)
+
+	full, err := NewSymmetricEigen(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+	expected := full.Values()
+
+	l, err := NewLanczos(m, 2, LargestReal, &KrylovSettings{Subspace: 4})
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	for k, value := range l.Values() {
+		if math.Abs(value-expected[4-k]) > 1e-8 {
+			t.Fatalf("The eigenvalues should be %v, but are %v.", expected[3:], l.Values())
+		}
+	}
+
+	checkSymmetricEigenpairs(t, m, l.Values(), l.Vectors(), l.Residuals())
+}
+
+func TestNewLanczosFailsWithoutEnoughRestarts(t *testing.T) {
+	m := pathLaplacian(100)
+
+	if _, err := NewLanczos(m, 4, SmallestReal, &KrylovSettings{MaxRestarts: 1}); err == nil {
+		t.Fatal("The iteration should not converge with only one restart.")
+	}
+}
+
+// Check that "m * x = value * x" holds within the reported residual estimates.
+func checkSymmetricEigenpairs(t *testing.T, m *dense.Matrix, values []float64, vectors *dense.Matrix, residuals []float64) {
+	rows := m.Rows()
+
+	for k, value := range values {
+		r := 0.0
+		length := 0.0
+
+		for i := 0; i < rows; i++ {
+			e := -value * vectors.Get(i, k)
+			for j := 0; j < rows; j++ {
+				e += m.Get(i, j) * vectors.Get(j, k)
+			}
+
+			r += e * e
+			length += vectors.Get(i, k) * vectors.Get(i, k)
+		}
+
+		if math.Abs(length-1) > 1e-10 {
+			t.Fatalf("The %d-th eigenvector should have unit length.", k)
+		}
+
+		if math.Abs(math.Sqrt(r)-residuals[k]) > 1e-8 {
+			t.Fatalf("The %d-th residual should be %v, but is estimated as %v.", k, math.Sqrt(r), residuals[k])
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	defaultNMFTolerance  = 1e-4
+	defaultNMFIterations = 200
+)
+
+// "NMFMethod" specifies the update rule of non-negative matrix factorization.
+type NMFMethod int
+
+const (
+	// The multiplicative updates of Lee and Seung.
+	MultiplicativeUpdate NMFMethod = iota
+
+	// The hierarchical alternating least squares of Cichocki and Phan.
+	HALS
+)
+
+/*
+"NMFSettings" controls non-negative matrix factorization.
+The zero value is available,
+where the method is the multiplicative updates, the tolerance is 1e-4,
+the maximum number of iterations is 200 and the seed of initialization is 0.
+*/
+type NMFSettings struct {
+	// The update rule.
+	Method NMFMethod
+
+	// The tolerance for the relative decrease of the reconstruction error in an iteration.
+	Tolerance float64
+
+	// The maximum number of iterations.
+	MaxIterations int
+
+	// The seed of random initialization.
+	Seed int64
+}
+
+/*
+"NMF" is the non-negative matrix factorization "m ~ w * h",
+where "w" and "h" are non-negative matrices of the requested rank.
+The input matrix is read only through "(Matrix).NonZeros",
+so the cost of an iteration is proportional to the number of non-zero elements
+apart from the terms of the rank.
+*/
+type NMF struct {
+	w         *arrays.Array
+	h         *arrays.Array
+	errors    []float64
+	converged bool
+}
+
+// "triplet" is a non-zero element of a matrix.
+type triplet struct {
+	row     int
+	column  int
+	element float64
+}
+
+// Factorize the non-negative matrix "m" into "w * h" of rank "rank".
+// When "rank" is not in [1, min(m.Rows(), m.Columns())], validates.OUT_OF_RANGE_PANIC will be caused.
+// When "m" has a negative element, "NegativeElementError" will be returned,
+// and when "m" has a NaN or infinite element, "NonFiniteError" will be returned.
+func NewNMF(m types.Matrix, rank int, s *NMFSettings) (*NMF, error) {
+	rows, columns := m.Shape()
+
+	if rank < 1 || rank > minInt(rows, columns) {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	if s == nil {
+		s = &NMFSettings{}
+	}
+
+	tolerance := s.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultNMFTolerance
+	}
+
+	iterations := s.MaxIterations
+	if iterations <= 0 {
+		iterations = defaultNMFIterations
+	}
+
+	elements := []triplet{}
+	total, squared := 0.0, 0.0
+
+	cursor := m.NonZeros()
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if math.IsNaN(element) || math.IsInf(element, 0) {
+			return nil, errors.New(NonFiniteError)
+		}
+
+		if element < 0 {
+			return nil, errors.New(NegativeElementError)
+		}
+
+		elements = append(elements, triplet{row: row, column: column, element: element})
+		total += element
+		squared += element * element
+	}
+
+	// Scale the random initialization to the average of elements as well as scikit-learn.
+	scale := math.Sqrt(total / float64(rows*columns*rank))
+	random := rand.New(rand.NewSource(s.Seed))
+
+	d := &NMF{
+		w: arrays.Zeros(rows, rank),
+		h: arrays.Zeros(rank, columns),
+	}
+
+	for _, a := range []*arrays.Array{d.w, d.h} {
+		x := a.Elements()
+		for i := range x {
+			x[i] = scale * random.Float64()
+		}
+	}
+
+	d.errors = append(d.errors, reconstructionError(elements, squared, d.w, d.h))
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		switch s.Method {
+		case HALS:
+			updateHALS(elements, d.w, d.h)
+		default:
+			updateMultiplicative(elements, d.w, d.h)
+		}
+
+		previous := d.errors[len(d.errors)-1]
+		current := reconstructionError(elements, squared, d.w, d.h)
+		d.errors = append(d.errors, current)
+
+		if previous == 0 || (previous-current) <= tolerance*previous {
+			d.converged = true
+			break
+		}
+	}
+
+	return d, nil
+}
+
+// Return the non-negative matrix "w" of shape "m.Rows() x rank".
+func (d *NMF) W() *dense.Matrix {
+	return d.w.Matrix()
+}
+
+// Return the non-negative matrix "h" of shape "rank x m.Columns()".
+func (d *NMF) H() *dense.Matrix {
+	return d.h.Matrix()
+}
+
+// Return the history of the reconstruction error "|m - w * h|" in the Frobenius norm,
+// where the first one is that of the initialization.
+// The error is computed without forming the residual, so it is accurate only relative to "|m|".
+func (d *NMF) Errors() []float64 {
+	errors := make([]float64, len(d.errors))
+	copy(errors, d.errors)
+
+	return errors
+}
+
+// Return whether the relative decrease of the error became smaller than the tolerance
+// within the maximum number of iterations.
+func (d *NMF) Converged() bool {
+	return d.converged
+}
+
+// Update "h" and then "w" with the multiplicative updates
+// "h <- h .* (w^T * m) ./ (w^T * w * h)" and "w <- w .* (m * h^T) ./ (w * h * h^T)".
+func updateMultiplicative(elements []triplet, w, h *arrays.Array) {
+	numerator := transposeProduct(elements, w, h.Columns())
+	denominator := gram(w).Multiply(h)
+
+	for i, x := range h.Elements() {
+		h.Elements()[i] = x * numerator.Elements()[i] / (denominator.Elements()[i] + arrays.Epsilon)
+	}
+
+	numerator = productTranspose(elements, h, w.Rows())
+	denominator = w.Multiply(gram(h.Transpose()))
+
+	for i, x := range w.Elements() {
+		w.Elements()[i] = x * numerator.Elements()[i] / (denominator.Elements()[i] + arrays.Epsilon)
+	}
+}
+
+// Update the rows of "h" and then the columns of "w" one by one
+// as the non-negative least squares solutions with the others fixed.
+func updateHALS(elements []triplet, w, h *arrays.Array) {
+	rank, columns := h.Shape()
+	rows := w.Rows()
+
+	a := gram(w)
+	b := transposeProduct(elements, w, columns)
+
+	for k := 0; k < rank; k++ {
+		diagonal := a.Get(k, k)
+		if diagonal == 0 {
+			continue
+		}
+
+		for j := 0; j < columns; j++ {
+			s := b.Get(k, j)
+			for l := 0; l < rank; l++ {
+				s -= a.Get(k, l) * h.Get(l, j)
+			}
+
+			h.Update(k, j, math.Max(h.Get(k, j)+s/diagonal, 0))
+		}
+	}
+
+	p := gram(h.Transpose())
+	q := productTranspose(elements, h, rows)
+
+	for k := 0; k < rank; k++ {
+		diagonal := p.Get(k, k)
+		if diagonal == 0 {
+			continue
+		}
+
+		for i := 0; i < rows; i++ {
+			s := q.Get(i, k)
+			for l := 0; l < rank; l++ {
+				s -= w.Get(i, l) * p.Get(l, k)
+			}
+
+			w.Update(i, k, math.Max(w.Get(i, k)+s/diagonal, 0))
+		}
+	}
+}
+
+// Compute "w^T * m" with "columns" columns from the non-zero elements of "m".
+func transposeProduct(elements []triplet, w *arrays.Array, columns int) *arrays.Array {
+	rank := w.Columns()
+	r := arrays.Zeros(rank, columns)
+
+	for _, t := range elements {
+		for k := 0; k < rank; k++ {
+			r.Update(k, t.column, r.Get(k, t.column)+w.Get(t.row, k)*t.element)
+		}
+	}
+
+	return r
+}
+
+// Compute "m * h^T" with "rows" rows from the non-zero elements of "m".
+func productTranspose(elements []triplet, h *arrays.Array, rows int) *arrays.Array {
+	rank := h.Rows()
+	r := arrays.Zeros(rows, rank)
+
+	for _, t := range elements {
+		for k := 0; k < rank; k++ {
+			r.Update(t.row, k, r.Get(t.row, k)+t.element*h.Get(k, t.column))
+		}
+	}
+
+	return r
+}
+
+// Compute "a^T * a".
+func gram(a *arrays.Array) *arrays.Array {
+	return a.Transpose().Multiply(a)
+}
+
+// Compute "|m - w * h|" in the Frobenius norm from the non-zero elements of "m" and "|m|^2"
+// as "|m|^2 - 2 * <m, w * h> + <w^T * w, h * h^T>".
+func reconstructionError(elements []triplet, squared float64, w, h *arrays.Array) float64 {
+	rank := w.Columns()
+	inner := 0.0
+
+	for _, t := range elements {
+		s := 0.0
+		for k := 0; k < rank; k++ {
+			s += w.Get(t.row, k) * h.Get(k, t.column)
+		}
+		inner += t.element * s
+	}
+
+	a := gram(w).Elements()
+	b := gram(h.Transpose()).Elements()
+
+	return math.Sqrt(math.Max(squared-2*inner+dotOf(a, b), 0))
+}
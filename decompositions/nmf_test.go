@@ -0,0 +1,133 @@
+package decompositions
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// "nonZerosOnly" is a matrix which fails when the elements are read except through "NonZeros".
+type nonZerosOnly struct {
+	*dense.Matrix
+}
+
+func (m nonZerosOnly) Get(row, column int) float64 {
+	panic("The elements should be read only through NonZeros.")
+}
+
+func (m nonZerosOnly) All() types.Cursor {
+	panic("The elements should be read only through NonZeros.")
+}
+
+// Create a sparse non-negative matrix of rank 3.
+func lowRankNonNegative() *dense.Matrix {
+	r := rand.New(rand.NewSource(0))
+
+	w := dense.Zeros(12, 3)
+	h := dense.Zeros(3, 10)
+	for i := 0; i < 12; i++ {
+		w.Update(i, i%3, 1+r.Float64())
+	}
+	for j := 0; j < 10; j++ {
+		for k := 0; k < 3; k++ {
+			if r.Float64() < 0.6 {
+				h.Update(k, j, r.Float64())
+			}
+		}
+	}
+
+	return w.Multiply(h).(*dense.Matrix)
+}
+
+func TestNewNMF(t *testing.T) {
+	m := lowRankNonNegative()
+
+	for _, method := range []NMFMethod{MultiplicativeUpdate, HALS} {
+		s := &NMFSettings{
+			Method:        method,
+			Tolerance:     1e-10,
+			MaxIterations: 5000,
+		}
+
+		d, err := NewNMF(nonZerosOnly{m}, 3, s)
+		if err != nil {
+			t.Fatalf("An unexpected error occured: %s", err)
+		}
+
+		// The error is computed without the dense residual, so it is accurate only relative to the first one.
+		errors := d.Errors()
+		for i := 1; i < len(errors); i++ {
+			if errors[i] > errors[i-1]+1e-6*errors[0] {
+				t.Fatalf("The reconstruction error should not increase: %v", errors[i-1:i+1])
+			}
+		}
+
+		w, h := d.W(), d.H()
+		if minW, _, _ := w.Min(); minW < 0 {
+			t.Fatal("The factors should be non-negative.")
+		}
+
+		if minH, _, _ := h.Min(); minH < 0 {
+			t.Fatal("The factors should be non-negative.")
+		}
+
+		if !equalApproximately(w.Multiply(h), m, 1e-2) {
+			t.Fatal("The factors should reconstruct the matrix of low rank.")
+		}
+	}
+}
+
+func TestNewNMFIsReproducible(t *testing.T) {
+	m := lowRankNonNegative()
+
+	s := &NMFSettings{Method: HALS, Seed: 42, MaxIterations: 10}
+
+	d, _ := NewNMF(m, 2, s)
+	e, _ := NewNMF(m, 2, s)
+
+	if !equalApproximately(d.W(), e.W(), 0) || !equalApproximately(d.H(), e.H(), 0) {
+		t.Fatal("The same seed should give the same factors.")
+	}
+
+	if len(d.Errors()) != 11 || d.Converged() {
+		t.Fatal("The iteration should stop at the maximum number of iterations.")
+	}
+}
+
+func TestNewNMFFailsForNegativeElement(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		-1, 0,
+	)
+
+	if _, err := NewNMF(m, 1, nil); err == nil || err.Error() != NegativeElementError {
+		t.Fatalf("A negative element should cause %s.", NegativeElementError)
+	}
+}
+
+func TestNewNMFCausesPanicForInvalidRank(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("An invalid rank should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	NewNMF(dense.Zeros(2, 3), 3, nil)
+}
+
+func TestNewNMFFailsForNonFiniteElement(t *testing.T) {
+	for _, element := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		m := dense.New(2, 2)(
+			1, 2,
+			element, 0,
+		)
+
+		if _, err := NewNMF(m, 1, nil); err == nil || err.Error() != NonFiniteError {
+			t.Fatalf("An element %v should cause %s.", element, NonFiniteError)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package decompositions
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Polar" is the polar decomposition "m = u * p",
+where "u" has orthonormal columns (or rows when "m" is wide)
+and "p" is symmetric positive semi-definite.
+"u" is the nearest matrix with orthonormal columns to "m" in the Frobenius norm.
+*/
+type Polar struct {
+	u *arrays.Array
+	p *arrays.Array
+}
+
+// Compute the polar decomposition of "m" from the singular value decomposition
+// "m = w * diag(sigma) * v^T" as "u = w * v^T" and "p = v * diag(sigma) * v^T".
+// When the singular value decomposition doesn't converge, an error will be returned.
+func NewPolar(m types.Matrix) (*Polar, error) {
+	s, err := NewSVD(m)
+	if err != nil {
+		return nil, err
+	}
+
+	vt := s.v.Transpose()
+
+	sv := s.v.Copy()
+	rows, columns := sv.Shape()
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			sv.Update(i, j, sv.Get(i, j)*s.values[j])
+		}
+	}
+
+	d := &Polar{
+		u: s.u.Multiply(vt),
+		p: sv.Multiply(vt),
+	}
+
+	return d, nil
+}
+
+// Return the factor "u" with orthonormal columns.
+func (d *Polar) U() *dense.Matrix {
+	return d.u.Matrix()
+}
+
+// Return the symmetric positive semi-definite factor "p".
+func (d *Polar) P() *dense.Matrix {
+	return d.p.Matrix()
+}
+
+// Return the orthogonal matrix "r" minimizing the Frobenius norm of "a * r - b",
+// which is "u * v^T" for the singular value decomposition "a^T * b = u * diag(sigma) * v^T".
+// When "proper" is true, "r" is restricted to a rotation with "det(r) = +1"
+// by flipping the singular vector for the smallest singular value if needed.
+// When "a" and "b" have different shapes, validates.DIFFERENT_SIZE_PANIC will be caused.
+// When the singular value decomposition doesn't converge, an error will be returned.
+func OrthogonalProcrustes(a, b types.Matrix, proper bool) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSame(a, b)
+
+	s, err := newSVD(arrays.Convert(a).Transpose().Multiply(arrays.Convert(b)))
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.u
+	n := u.Rows()
+
+	if proper && arrays.NewLU(u, 0).Determinant()*arrays.NewLU(s.v, 0).Determinant() < 0 {
+		for i := 0; i < n; i++ {
+			u.Update(i, n-1, -u.Get(i, n-1))
+		}
+	}
+
+	return u.Multiply(s.v.Transpose()).Matrix(), nil
+}
@@ -0,0 +1,157 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestNewPolar(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 2,
+		0, 1,
+		-1, 3,
+	)
+
+	d, err := NewPolar(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	u, p := d.U(), d.P()
+
+	if !equalApproximately(u.Transpose().Multiply(u), arrays.Identity(2).Matrix(), 1e-12) {
+		t.Fatal("The factor u should have orthonormal columns.")
+	}
+
+	if !equalApproximately(p, p.Transpose(), 1e-12) {
+		t.Fatal("The factor p should be symmetric.")
+	}
+
+	if _, err := NewCholesky(p); err != nil {
+		t.Fatal("The factor p should be positive definite for a matrix of full rank.")
+	}
+
+	if !equalApproximately(u.Multiply(p), m, 1e-12) {
+		t.Fatal("The product u * p should be the matrix.")
+	}
+}
+
+func TestNewPolarAtExtremeMagnitude(t *testing.T) {
+	m := dense.New(2, 2)(
+		1e200, 0,
+		0, 2e200,
+	)
+
+	d, err := NewPolar(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(d.U(), arrays.Identity(2).Matrix(), 1e-12) {
+		t.Fatal("The factor u should be the identity for a positive diagonal matrix.")
+	}
+
+	if !equalApproximately(d.P().Scalar(1e-200), dense.New(2, 2)(1, 0, 0, 2), 1e-12) {
+		t.Fatal("The factor p should be the matrix itself for a positive diagonal matrix.")
+	}
+}
+
+func TestNewPolarForSingularMatrix(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 1,
+		1, 1,
+	)
+
+	d, err := NewPolar(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	u := d.U()
+
+	if !equalApproximately(u.Transpose().Multiply(u), arrays.Identity(2).Matrix(), 1e-12) {
+		t.Fatal("The factor u should be orthogonal for a singular matrix.")
+	}
+
+	if !equalApproximately(u.Multiply(d.P()), m, 1e-12) {
+		t.Fatal("The product u * p should be the matrix.")
+	}
+}
+
+func TestOrthogonalProcrustesRecoversRotation(t *testing.T) {
+	a := dense.New(4, 3)(
+		1, 0, 2,
+		0, 3, 1,
+		-1, 1, 0,
+		2, -2, 1,
+	)
+
+	theta := 0.7
+	r := dense.New(3, 3)(
+		math.Cos(theta), -math.Sin(theta), 0,
+		math.Sin(theta), math.Cos(theta), 0,
+		0, 0, 1,
+	)
+
+	b := a.Multiply(r)
+
+	solution, err := OrthogonalProcrustes(a, b, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(solution, r, 1e-12) {
+		t.Fatal("The rotation should be recovered.")
+	}
+}
+
+func TestOrthogonalProcrustesRestrictsToRotation(t *testing.T) {
+	a := dense.New(3, 2)(
+		1, 0,
+		0, 2,
+		1, 1,
+	)
+
+	// "b" is the reflection of "a", which is the best orthogonal solution.
+	reflection := dense.New(2, 2)(
+		1, 0,
+		0, -1,
+	)
+	b := a.Multiply(reflection)
+
+	solution, err := OrthogonalProcrustes(a, b, false)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(solution, reflection, 1e-12) {
+		t.Fatal("The reflection should be returned without the restriction.")
+	}
+
+	rotation, err := OrthogonalProcrustes(a, b, true)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(rotation.Transpose().Multiply(rotation), arrays.Identity(2).Matrix(), 1e-12) {
+		t.Fatal("The solution should be orthogonal.")
+	}
+
+	if det := rotation.Get(0, 0)*rotation.Get(1, 1) - rotation.Get(0, 1)*rotation.Get(1, 0); math.Abs(det-1) > 1e-12 {
+		t.Fatal("The solution should be a proper rotation.")
+	}
+}
+
+func TestOrthogonalProcrustesCausesPanicForDifferentShapes(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.DIFFERENT_SIZE_PANIC {
+			t.Fatalf("Different shapes should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+		}
+	}()
+
+	OrthogonalProcrustes(dense.Zeros(3, 2), dense.Zeros(2, 3), false)
+}
@@ -0,0 +1,174 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"RREF" is the reduced row echelon form of a matrix computed by Gauss-Jordan elimination with partial pivoting.
+An element whose absolute value is not greater than the tolerance is regarded as zero.
+*/
+type RREF struct {
+	r      *arrays.Array
+	pivots []int
+}
+
+// Compute the reduced row echelon form of "m".
+// When "tolerance" is not positive, "max(rows, columns) * epsilon * |m|_inf" is used,
+// where "epsilon" is the machine epsilon and "|m|_inf" is the maximum absolute row sum.
+func NewRREF(m types.Matrix, tolerance float64) *RREF {
+	r := arrays.Convert(m)
+	rows, columns := r.Shape()
+
+	if tolerance <= 0 {
+		tolerance = defaultRankTolerance(r)
+	}
+
+	pivots := []int{}
+
+	for j, i := 0, 0; j < columns && i < rows; j++ {
+		// Choose the pivot with the largest absolute value in the column.
+		p := i
+		for k := i + 1; k < rows; k++ {
+			if math.Abs(r.Get(k, j)) > math.Abs(r.Get(p, j)) {
+				p = k
+			}
+		}
+
+		if math.Abs(r.Get(p, j)) <= tolerance {
+			for k := i; k < rows; k++ {
+				r.Update(k, j, 0)
+			}
+			continue
+		}
+
+		pivot := r.Get(p, j)
+		for k := j; k < columns; k++ {
+			x, y := r.Get(i, k), r.Get(p, k)
+			r.Update(p, k, x)
+			r.Update(i, k, y/pivot)
+		}
+
+		for k := 0; k < rows; k++ {
+			factor := r.Get(k, j)
+			if k == i || factor == 0 {
+				continue
+			}
+
+			for l := j; l < columns; l++ {
+				r.Update(k, l, r.Get(k, l)-factor*r.Get(i, l))
+			}
+		}
+
+		pivots = append(pivots, j)
+		i++
+	}
+
+	d := &RREF{
+		r:      r,
+		pivots: pivots,
+	}
+
+	return d
+}
+
+// Return the reduced row echelon form.
+func (d *RREF) R() *dense.Matrix {
+	return d.r.Matrix()
+}
+
+// Return the indices of pivot columns in ascending order.
+func (d *RREF) Pivots() []int {
+	pivots := make([]int, len(d.pivots))
+	copy(pivots, d.pivots)
+
+	return pivots
+}
+
+// Return the rank, which is the number of pivot columns.
+func (d *RREF) Rank() int {
+	return len(d.pivots)
+}
+
+// Return the matrix whose columns are a basis of the null space.
+// The basis vector for each free column has one at the column and zeros at the other free columns.
+// When the null space is trivial, nil is returned.
+func (d *RREF) NullSpace() *dense.Matrix {
+	columns := d.r.Columns()
+	if len(d.pivots) == columns {
+		return nil
+	}
+
+	basis := arrays.Zeros(columns, columns-len(d.pivots))
+
+	free := 0
+	for j, p := 0, 0; j < columns; j++ {
+		if p < len(d.pivots) && d.pivots[p] == j {
+			p++
+			continue
+		}
+
+		basis.Update(j, free, 1)
+		for i, pivot := range d.pivots {
+			basis.Update(pivot, free, -d.r.Get(i, j))
+		}
+		free++
+	}
+
+	return basis.Matrix()
+}
+
+// Return the rank of "m" computed with the reduced row echelon form.
+// "tolerance" is interpreted as same as "NewRREF".
+func Rank(m types.Matrix, tolerance float64) int {
+	return NewRREF(m, tolerance).Rank()
+}
+
+// Return the matrix whose columns are a basis of the null space of "m".
+// "tolerance" is interpreted as same as "NewRREF".
+// When the null space is trivial, nil is returned.
+func NullSpace(m types.Matrix, tolerance float64) *dense.Matrix {
+	return NewRREF(m, tolerance).NullSpace()
+}
+
+// Return the matrix whose columns are the pivot columns of "m", which are a basis of the column space.
+// "tolerance" is interpreted as same as "NewRREF".
+// When "m" is regarded as zero, nil is returned.
+func ColumnSpace(m types.Matrix, tolerance float64) *dense.Matrix {
+	pivots := NewRREF(m, tolerance).pivots
+	if len(pivots) == 0 {
+		return nil
+	}
+
+	basis := dense.Zeros(m.Rows(), len(pivots))
+
+	// The columns are copied element by element,
+	// since "(Matrix).Column" of a transposed matrix doesn't rewrite the indexes.
+	for k, j := range pivots {
+		for i := 0; i < m.Rows(); i++ {
+			basis.Update(i, k, m.Get(i, j))
+		}
+	}
+
+	return basis
+}
+
+// Return "max(rows, columns) * epsilon * |a|_inf".
+func defaultRankTolerance(a *arrays.Array) float64 {
+	rows, columns := a.Shape()
+
+	norm := 0.0
+	for i := 0; i < rows; i++ {
+		s := 0.0
+		for j := 0; j < columns; j++ {
+			s += math.Abs(a.Get(i, j))
+		}
+		norm = math.Max(norm, s)
+	}
+
+	return float64(maxInt(rows, columns)) * arrays.Epsilon * norm
+}
@@ -0,0 +1,103 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewRREFComputesPivots(t *testing.T) {
+	m := dense.New(3, 4)(
+		1, 2, 1, 1,
+		2, 4, 0, 6,
+		1, 2, 2, -1,
+	)
+
+	d := NewRREF(m, 0)
+
+	r := dense.New(3, 4)(
+		1, 2, 0, 3,
+		0, 0, 1, -2,
+		0, 0, 0, 0,
+	)
+
+	if !equalApproximately(d.R(), r, 1e-12) {
+		t.Fatal("The reduced row echelon form should be computed.")
+	}
+
+	pivots := d.Pivots()
+	if len(pivots) != 2 || pivots[0] != 0 || pivots[1] != 2 || d.Rank() != 2 {
+		t.Fatalf("The pivot columns should be [0 2], but are %v.", pivots)
+	}
+}
+
+func TestNullSpaceIsAnnihilated(t *testing.T) {
+	m := dense.New(3, 4)(
+		1, 2, 1, 1,
+		2, 4, 0, 6,
+		1, 2, 2, -1,
+	)
+
+	n := NullSpace(m, 0)
+	if n.Rows() != 4 || n.Columns() != 2 {
+		t.Fatal("The null space should have the dimension 2.")
+	}
+
+	if !equalApproximately(m.Multiply(n), dense.Zeros(3, 2), 1e-12) {
+		t.Fatal("The basis of null space should be annihilated by the matrix.")
+	}
+
+	if NullSpace(dense.New(2, 2)(1, 2, 3, 4), 0) != nil {
+		t.Fatal("The null space of non-singular matrix should be trivial.")
+	}
+}
+
+func TestColumnSpaceConsistsOfPivotColumns(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		2, 4, 1,
+		3, 6, 0,
+	)
+
+	c := ColumnSpace(m, 0)
+
+	expected := dense.New(3, 2)(
+		1, 3,
+		2, 1,
+		3, 0,
+	)
+
+	if !c.Equal(expected) {
+		t.Fatal("The basis of column space should be the pivot columns.")
+	}
+
+	if ColumnSpace(dense.Zeros(2, 2), 0) != nil {
+		t.Fatal("The column space of zero matrix should be trivial.")
+	}
+}
+
+func TestColumnSpaceOfTransposedMatrix(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	).Transpose()
+
+	if c := ColumnSpace(m, 0); !c.Equal(m) {
+		t.Fatal("The basis of column space should be the columns of the transposed matrix.")
+	}
+}
+
+func TestRankDependsOnTolerance(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 1,
+		1, 1+1e-9,
+	)
+
+	if Rank(m, 0) != 2 {
+		t.Fatal("The default tolerance should regard the matrix as non-singular.")
+	}
+
+	if Rank(m, 1e-6) != 1 {
+		t.Fatal("The larger tolerance should regard the matrix as rank deficient.")
+	}
+}
@@ -0,0 +1,371 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// The maximum number of QR iterations spent on each eigenvalue.
+const schurIterations = 100
+
+/*
+"Schur" is the real Schur decomposition "m = z * t * z^T" of a square matrix,
+where "t" is upper quasi-triangular and "z" is orthogonal.
+Each diagonal block of "t" is 1 x 1 for a real eigenvalue
+or 2 x 2 for a pair of complex conjugate eigenvalues.
+*/
+type Schur struct {
+	t      *arrays.Array
+	z      *arrays.Array
+	values []complex128
+}
+
+// Compute the real Schur decomposition of the square matrix "m".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When the QR iteration doesn't converge, an error will be returned.
+func NewSchur(m types.Matrix) (*Schur, error) {
+	return NewSchurFromHessenberg(NewHessenberg(m))
+}
+
+// Compute the real Schur decomposition from the Hessenberg decomposition "h"
+// with the Francis double-shift QR iteration.
+// When the QR iteration doesn't converge, an error will be returned.
+func NewSchurFromHessenberg(h *Hessenberg) (*Schur, error) {
+	t := h.h.Copy()
+	z := h.q.Copy()
+
+	values, err := francis(t, z)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Schur{
+		t:      t,
+		z:      z,
+		values: values,
+	}
+
+	return d, nil
+}
+
+// Return the upper quasi-triangular matrix "t".
+func (d *Schur) T() *dense.Matrix {
+	return d.t.Matrix()
+}
+
+// Return the orthogonal matrix "z".
+func (d *Schur) Z() *dense.Matrix {
+	return d.z.Matrix()
+}
+
+// Return the eigenvalues in the order of the diagonal blocks of "t".
+// A pair of complex conjugate eigenvalues is ordered so that
+// the one with positive imaginary part comes first.
+func (d *Schur) Eigenvalues() []complex128 {
+	values := make([]complex128, len(d.values))
+	copy(values, d.values)
+
+	return values
+}
+
+// Reduce the upper Hessenberg array "t" to the real Schur form in place,
+// accumulate the transformations into "z" and return the eigenvalues.
+// This is based on the procedure "hqr2" of EISPACK.
+func francis(t, z *arrays.Array) ([]complex128, error) {
+	size := t.Rows()
+	values := make([]complex128, size)
+
+	norm := 0.0
+	for i := 0; i < size; i++ {
+		for j := maxInt(i-1, 0); j < size; j++ {
+			norm += math.Abs(t.Get(i, j))
+		}
+	}
+
+	var p, q, r, s, w, x, y, v float64
+
+	shift := 0.0
+	iterations := 0
+
+	n := size - 1
+
+	for n >= 0 {
+		// Look for a single small sub-diagonal element.
+		l := n
+		for l > 0 {
+			s = math.Abs(t.Get(l-1, l-1)) + math.Abs(t.Get(l, l))
+			if s == 0 {
+				s = norm
+			}
+
+			if math.Abs(t.Get(l, l-1)) <= arrays.Epsilon*s {
+				t.Update(l, l-1, 0)
+				break
+			}
+
+			l--
+		}
+
+		switch l {
+		case n:
+			// One root is found.
+			t.Update(n, n, t.Get(n, n)+shift)
+			values[n] = complex(t.Get(n, n), 0)
+
+			n--
+			iterations = 0
+
+		case n - 1:
+			// Two roots are found.
+			w = t.Get(n, n-1) * t.Get(n-1, n)
+			p = (t.Get(n-1, n-1) - t.Get(n, n)) / 2
+			q = p*p + w
+			v = math.Sqrt(math.Abs(q))
+
+			t.Update(n, n, t.Get(n, n)+shift)
+			t.Update(n-1, n-1, t.Get(n-1, n-1)+shift)
+			x = t.Get(n, n)
+
+			if q >= 0 {
+				// A real pair is standardized to be upper triangular.
+				if p >= 0 {
+					v = p + v
+				} else {
+					v = p - v
+				}
+
+				values[n-1] = complex(x+v, 0)
+				values[n] = values[n-1]
+				if v != 0 {
+					values[n] = complex(x-w/v, 0)
+				}
+
+				x = t.Get(n, n-1)
+				s = math.Abs(x) + math.Abs(v)
+				p = x / s
+				q = v / s
+				r = math.Sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				for j := n - 1; j < size; j++ {
+					v = t.Get(n-1, j)
+					t.Update(n-1, j, q*v+p*t.Get(n, j))
+					t.Update(n, j, q*t.Get(n, j)-p*v)
+				}
+
+				for i := 0; i <= n; i++ {
+					v = t.Get(i, n-1)
+					t.Update(i, n-1, q*v+p*t.Get(i, n))
+					t.Update(i, n, q*t.Get(i, n)-p*v)
+				}
+
+				for i := 0; i < size; i++ {
+					v = z.Get(i, n-1)
+					z.Update(i, n-1, q*v+p*z.Get(i, n))
+					z.Update(i, n, q*z.Get(i, n)-p*v)
+				}
+
+				t.Update(n, n-1, 0)
+			} else {
+				values[n-1] = complex(x+p, v)
+				values[n] = complex(x+p, -v)
+			}
+
+			n -= 2
+			iterations = 0
+
+		default:
+			if iterations >= schurIterations {
+				return nil, errors.New(NotConvergedError)
+			}
+
+			// Form a shift.
+			x = t.Get(n, n)
+			y = t.Get(n-1, n-1)
+			w = t.Get(n, n-1) * t.Get(n-1, n)
+
+			// Use exceptional shifts to break a cycle.
+			if iterations == 10 {
+				shift += x
+				for i := 0; i <= n; i++ {
+					t.Update(i, i, t.Get(i, i)-x)
+				}
+
+				s = math.Abs(t.Get(n, n-1)) + math.Abs(t.Get(n-1, n-2))
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			if iterations == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						t.Update(i, i, t.Get(i, i)-s)
+					}
+
+					shift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iterations++
+
+			// Look for two consecutive small sub-diagonal elements.
+			m := n - 2
+			for m >= l {
+				v = t.Get(m, m)
+				r = x - v
+				s = y - v
+				p = (r*s-w)/t.Get(m+1, m) + t.Get(m, m+1)
+				q = t.Get(m+1, m+1) - v - r - s
+				r = t.Get(m+2, m+1)
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+
+				if m == l {
+					break
+				}
+
+				left := math.Abs(t.Get(m, m-1)) * (math.Abs(q) + math.Abs(r))
+				right := arrays.Epsilon * (math.Abs(p) * (math.Abs(t.Get(m-1, m-1)) + math.Abs(v) + math.Abs(t.Get(m+1, m+1))))
+				if left < right {
+					break
+				}
+
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				t.Update(i, i-2, 0)
+				if i > m+2 {
+					t.Update(i, i-3, 0)
+				}
+			}
+
+			// Perform a double QR step on rows "l" to "n" and columns "m" to "n".
+			for k := m; k <= n-1; k++ {
+				last := k == n-1
+
+				if k != m {
+					p = t.Get(k, k-1)
+					q = t.Get(k+1, k-1)
+					r = 0
+					if !last {
+						r = t.Get(k+2, k-1)
+					}
+
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+
+				if s == 0 {
+					continue
+				}
+
+				if k != m {
+					t.Update(k, k-1, -s*x)
+				} else if l != m {
+					t.Update(k, k-1, -t.Get(k, k-1))
+				}
+
+				p += s
+				x = p / s
+				y = q / s
+				v = r / s
+				q /= p
+				r /= p
+
+				for j := k; j < size; j++ {
+					p = t.Get(k, j) + q*t.Get(k+1, j)
+					if !last {
+						p += r * t.Get(k+2, j)
+						t.Update(k+2, j, t.Get(k+2, j)-p*v)
+					}
+
+					t.Update(k, j, t.Get(k, j)-p*x)
+					t.Update(k+1, j, t.Get(k+1, j)-p*y)
+				}
+
+				for i := 0; i <= minInt(n, k+3); i++ {
+					p = x*t.Get(i, k) + y*t.Get(i, k+1)
+					if !last {
+						p += v * t.Get(i, k+2)
+						t.Update(i, k+2, t.Get(i, k+2)-p*r)
+					}
+
+					t.Update(i, k, t.Get(i, k)-p)
+					t.Update(i, k+1, t.Get(i, k+1)-p*q)
+				}
+
+				for i := 0; i < size; i++ {
+					p = x*z.Get(i, k) + y*z.Get(i, k+1)
+					if !last {
+						p += v * z.Get(i, k+2)
+						z.Update(i, k+2, z.Get(i, k+2)-p*r)
+					}
+
+					z.Update(i, k, z.Get(i, k)-p)
+					z.Update(i, k+1, z.Get(i, k+1)-p*q)
+				}
+			}
+		}
+	}
+
+	// Clear the elements below the diagonal blocks left by the bulge chasing.
+	for i := 1; i < size; i++ {
+		for j := 0; j < i-1; j++ {
+			t.Update(i, j, 0)
+		}
+
+		if imag(values[i-1]) <= 0 || imag(values[i]) >= 0 {
+			t.Update(i, i-1, 0)
+		}
+	}
+
+	return values, nil
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+
+	return y
+}
+
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+
+	return y
+}
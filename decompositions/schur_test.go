@@ -0,0 +1,94 @@
+package decompositions
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewSchurReconstructsTheOriginal(t *testing.T) {
+	m := dense.New(4, 4)(
+		1, 2, 3, 4,
+		-1, 0, 2, 1,
+		3, 1, -2, 0,
+		0, 5, 1, 1,
+	)
+
+	s, err := NewSchur(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	z, r := s.Z(), s.T()
+
+	if !equalApproximately(z.Multiply(r).Multiply(z.Transpose()), m, 1e-10) {
+		t.Fatal("The product z * t * z^T should equal to the original matrix.")
+	}
+
+	cursor := r.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if row > column+1 && element != 0 {
+			t.Fatalf("The element at (%d, %d) should be zero, but is %f.", row, column, element)
+		}
+	}
+}
+
+func TestNewSchurFindsComplexEigenvalues(t *testing.T) {
+	// The rotation by 90 degrees has the eigenvalues "i" and "-i".
+	m := dense.New(3, 3)(
+		0, -1, 0,
+		1, 0, 0,
+		0, 0, 2,
+	)
+
+	s, err := NewSchur(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := []complex128{complex(0, 1), complex(0, -1), complex(2, 0)}
+
+	for _, e := range expected {
+		found := false
+
+		for _, v := range s.Eigenvalues() {
+			if cmplx.Abs(v-e) < 1e-12 {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Fatalf("The eigenvalue %v should be found in %v.", e, s.Eigenvalues())
+		}
+	}
+}
+
+func TestNewSchurFromHessenbergReusesTheReduction(t *testing.T) {
+	m := dense.New(3, 3)(
+		2, 1, 0,
+		1, 3, 1,
+		0, 1, 4,
+	)
+
+	h := NewHessenberg(m)
+
+	s, err := NewSchurFromHessenberg(h)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	z, r := s.Z(), s.T()
+
+	if !equalApproximately(z.Multiply(r).Multiply(z.Transpose()), m, 1e-12) {
+		t.Fatal("The product z * t * z^T should equal to the original matrix.")
+	}
+
+	if !equalApproximately(h.H(), NewHessenberg(m).H(), 0) {
+		t.Fatal("The Hessenberg decomposition should not be modified.")
+	}
+}
@@ -0,0 +1,139 @@
+package decompositions
+
+import (
+	"math/big"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"Smith" is the Smith normal form "u * m * v = s" of an integer matrix,
+where "u" and "v" are unimodular and "s" is diagonal
+with non-negative invariant factors, each of which divides the next one.
+The elements are arbitrary-precision integers, so they never overflow.
+*/
+type Smith struct {
+	s integers
+	u integers
+	v integers
+}
+
+// Compute the Smith normal form of the integer matrix "m".
+// When "m" has an element which is not an integer, "*NonIntegralError" will be returned.
+func NewSmith(m types.Matrix) (*Smith, error) {
+	s, err := integersOf(m)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, columns := m.Shape()
+	u := identityIntegers(rows)
+	v := identityIntegers(columns)
+
+	remainder := new(big.Int)
+
+	for t := 0; t < minInt(rows, columns); t++ {
+		// Move the non-zero element with the smallest absolute value to the pivot.
+		pi, pj := -1, -1
+		for i := t; i < rows; i++ {
+			for j := t; j < columns; j++ {
+				if s[i][j].Sign() != 0 && (pi < 0 || s[i][j].CmpAbs(s[pi][pj]) < 0) {
+					pi, pj = i, j
+				}
+			}
+		}
+
+		if pi < 0 {
+			break
+		}
+
+		s.swapRows(t, pi)
+		u.swapRows(t, pi)
+		s.swapColumns(t, pj)
+		v.swapColumns(t, pj)
+
+		for {
+			// Clear the column and the row of the pivot alternately,
+			// which decreases the absolute value of the pivot until both are cleared.
+			for cleared := false; !cleared; {
+				cleared = true
+
+				for i := t + 1; i < rows; i++ {
+					if s[i][t].Sign() != 0 {
+						x, y, p, q := eliminator(s[t][t], s[i][t])
+						s.combineRows(t, i, x, y, p, q)
+						u.combineRows(t, i, x, y, p, q)
+					}
+				}
+
+				for j := t + 1; j < columns; j++ {
+					if s[t][j].Sign() != 0 {
+						x, y, p, q := eliminator(s[t][t], s[t][j])
+						s.combineColumns(t, j, x, y, p, q)
+						v.combineColumns(t, j, x, y, p, q)
+						cleared = false
+					}
+				}
+			}
+
+			// The pivot should divide all the remaining elements.
+			// Otherwise, the row with such an element is added to the pivot row and cleared again.
+			k := -1
+			for i := t + 1; i < rows && k < 0; i++ {
+				for j := t + 1; j < columns; j++ {
+					if remainder.Rem(s[i][j], s[t][t]).Sign() != 0 {
+						k = i
+						break
+					}
+				}
+			}
+
+			if k < 0 {
+				break
+			}
+
+			minusOne := big.NewInt(-1)
+			s.subtractRow(t, k, minusOne)
+			u.subtractRow(t, k, minusOne)
+		}
+
+		if s[t][t].Sign() < 0 {
+			s.negateRow(t)
+			u.negateRow(t)
+		}
+	}
+
+	d := &Smith{
+		s: s,
+		u: u,
+		v: v,
+	}
+
+	return d, nil
+}
+
+// Return the diagonal matrix "s".
+func (d *Smith) S() [][]*big.Int {
+	return d.s.copy()
+}
+
+// Return the unimodular matrix "u" applied from the left.
+func (d *Smith) U() [][]*big.Int {
+	return d.u.copy()
+}
+
+// Return the unimodular matrix "v" applied from the right.
+func (d *Smith) V() [][]*big.Int {
+	return d.v.copy()
+}
+
+// Return the invariant factors, which are the diagonal elements of "s".
+func (d *Smith) Factors() []*big.Int {
+	factors := make([]*big.Int, minInt(len(d.s), len(d.v)))
+
+	for i := range factors {
+		factors[i] = new(big.Int).Set(d.s[i][i])
+	}
+
+	return factors
+}
@@ -0,0 +1,79 @@
+package decompositions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func checkSmith(t *testing.T, m *dense.Matrix, d *Smith, factors ...int64) {
+	a, _ := integersOf(m)
+
+	if !equalIntegers(multiplyIntegers(multiplyIntegers(d.U(), a), d.V()), d.S()) {
+		t.Fatal("The transformations should satisfy u * m * v = s.")
+	}
+
+	checkUnimodular(t, d.U())
+	checkUnimodular(t, d.V())
+
+	s := d.S()
+	for i := range s {
+		for j := range s[i] {
+			if i != j && s[i][j].Sign() != 0 {
+				t.Fatal("The Smith normal form should be diagonal.")
+			}
+		}
+	}
+
+	for i, factor := range d.Factors() {
+		if factor.Cmp(big.NewInt(factors[i])) != 0 {
+			t.Fatalf("The invariant factors should be %v, but are %v.", factors, d.Factors())
+		}
+	}
+}
+
+func TestNewSmith(t *testing.T) {
+	m := dense.New(3, 3)(
+		2, 4, 4,
+		-6, 6, 12,
+		10, -4, -16,
+	)
+
+	d, err := NewSmith(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSmith(t, m, d, 2, 6, 12)
+}
+
+func TestNewSmithForcesDivisibility(t *testing.T) {
+	// The diagonal matrix "diag(2, 3)" is not in Smith normal form.
+	m := dense.New(3, 2)(
+		2, 0,
+		0, 3,
+		0, 0,
+	)
+
+	d, err := NewSmith(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSmith(t, m, d, 1, 6)
+}
+
+func TestNewSmithForDeficientMatrix(t *testing.T) {
+	m := dense.New(2, 4)(
+		1, 2, 3, 4,
+		2, 4, 6, 8,
+	)
+
+	d, err := NewSmith(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSmith(t, m, d, 1, 0)
+}
@@ -0,0 +1,246 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// The maximum number of sweeps of the one-sided Jacobi method.
+const svdSweeps = 60
+
+/*
+"SVD" is the thin singular value decomposition "m = u * diag(values) * v^T",
+where "u" and "v" have "min(m.Rows(), m.Columns())" orthonormal columns.
+The singular values are non-negative and sorted in descending order.
+*/
+type SVD struct {
+	values []float64
+	u      *arrays.Array
+	v      *arrays.Array
+}
+
+// Compute the thin singular value decomposition of "m" with the one-sided Jacobi method.
+// The left singular vectors for zero singular values are completed to an orthonormal set.
+// When the iteration doesn't converge, an error will be returned.
+func NewSVD(m types.Matrix) (*SVD, error) {
+	return newSVD(arrays.Convert(m))
+}
+
+func newSVD(m *arrays.Array) (*SVD, error) {
+	rows, columns := m.Shape()
+
+	if rows < columns {
+		d, err := newSVD(m.Transpose())
+		if err != nil {
+			return nil, err
+		}
+
+		d.u, d.v = d.v, d.u
+
+		return d, nil
+	}
+
+	// Each row of "a" and "v" is a column of the matrix being orthogonalized.
+	a := m.Transpose()
+	v := arrays.Identity(columns)
+
+	if err := orthogonalizeColumns(a, v); err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, columns)
+	for j := range values {
+		values[j] = normOf(rowOf(a, j))
+	}
+
+	order := make([]int, columns)
+	for j := range order {
+		order[j] = j
+	}
+	sort.Stable(&byValue{order: order, values: values})
+
+	d := &SVD{
+		values: make([]float64, columns),
+		u:      arrays.Zeros(rows, columns),
+		v:      arrays.Zeros(columns, columns),
+	}
+
+	// A column is regarded as zero when it is negligible against the largest one.
+	threshold := 0.0
+	if columns > 0 {
+		threshold = float64(rows) * arrays.Epsilon * values[order[0]]
+	}
+
+	basis := make([][]float64, 0, columns)
+
+	for k, j := range order {
+		for i := 0; i < columns; i++ {
+			d.v.Update(i, k, v.Get(j, i))
+		}
+
+		if values[j] <= threshold {
+			continue
+		}
+
+		d.values[k] = values[j]
+
+		u := rowOf(a, j)
+		for i := range u {
+			u[i] /= values[j]
+		}
+		basis = append(basis, u)
+
+		for i := 0; i < rows; i++ {
+			d.u.Update(i, k, u[i])
+		}
+	}
+
+	// Complete the left singular vectors with the standard basis orthogonalized against them.
+	for k, e := len(basis), 0; k < columns; e++ {
+		u := make([]float64, rows)
+		u[e] = 1
+
+		for pass := 0; pass < gramSchmidtPasses; pass++ {
+			for _, w := range basis {
+				s := dotOf(w, u)
+				for i := range u {
+					u[i] -= s * w[i]
+				}
+			}
+		}
+
+		length := normOf(u)
+		if length < 0.5 {
+			continue
+		}
+
+		for i := range u {
+			u[i] /= length
+			d.u.Update(i, k, u[i])
+		}
+		basis = append(basis, u)
+		k++
+	}
+
+	return d, nil
+}
+
+// Return the singular values in descending order.
+func (d *SVD) Values() []float64 {
+	values := make([]float64, len(d.values))
+	copy(values, d.values)
+
+	return values
+}
+
+// Return the matrix whose columns are the left singular vectors.
+func (d *SVD) U() *dense.Matrix {
+	return d.u.Matrix()
+}
+
+// Return the matrix whose columns are the right singular vectors.
+func (d *SVD) V() *dense.Matrix {
+	return d.v.Matrix()
+}
+
+// Apply Jacobi rotations to the rows of "a" until they are mutually orthogonal,
+// accumulating the same rotations to the rows of "v".
+// This is the method of Hestenes, where the rows are the columns of the original matrix.
+func orthogonalizeColumns(a, v *arrays.Array) error {
+	n, size := a.Shape()
+	elements := a.Elements()
+	vectors := v.Elements()
+
+	for sweep := 0; sweep < svdSweeps; sweep++ {
+		rotated := false
+
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				x := elements[p*size : (p+1)*size]
+				y := elements[q*size : (q+1)*size]
+
+				// The rotation is computed from the norms and the cosine of the columns
+				// instead of the squared norms and the inner product, which overflow and underflow.
+				alpha := normOf(x)
+				beta := normOf(y)
+				if alpha == 0 || beta == 0 {
+					continue
+				}
+
+				gamma := cosineOf(x, y, alpha, beta)
+				if gamma == 0 || math.Abs(gamma) <= arrays.Epsilon {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta/alpha - alpha/beta) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Hypot(1, zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				rotate(x, y, c, s)
+				rotate(vectors[p*n:(p+1)*n], vectors[q*n:(q+1)*n], c, s)
+			}
+		}
+
+		if !rotated {
+			return nil
+		}
+	}
+
+	return errors.New(NotConvergedError)
+}
+
+// Return the cosine of the angle between "x" and "y" whose norms are "alpha" and "beta".
+func cosineOf(x, y []float64, alpha, beta float64) float64 {
+	s := 0.0
+
+	for i := range x {
+		s += (x[i] / alpha) * (y[i] / beta)
+	}
+
+	return s
+}
+
+// Replace "x" and "y" with "c * x - s * y" and "s * x + c * y".
+func rotate(x, y []float64, c, s float64) {
+	for i := range x {
+		xi, yi := x[i], y[i]
+		x[i] = c*xi - s*yi
+		y[i] = s*xi + c*yi
+	}
+}
+
+// Copy the "i"-th row of "a" into a slice.
+func rowOf(a *arrays.Array, i int) []float64 {
+	columns := a.Columns()
+	x := make([]float64, columns)
+	copy(x, a.Elements()[i*columns:(i+1)*columns])
+
+	return x
+}
+
+type byValue struct {
+	order  []int
+	values []float64
+}
+
+func (b *byValue) Len() int {
+	return len(b.order)
+}
+
+func (b *byValue) Less(i, j int) bool {
+	return b.values[b.order[i]] > b.values[b.order[j]]
+}
+
+func (b *byValue) Swap(i, j int) {
+	b.order[i], b.order[j] = b.order[j], b.order[i]
+}
@@ -0,0 +1,122 @@
+package decompositions
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+)
+
+func checkSVD(t *testing.T, m *dense.Matrix, d *SVD) {
+	u, v := d.U(), d.V()
+	k := len(d.Values())
+
+	for j := 1; j < k; j++ {
+		if d.Values()[j] > d.Values()[j-1] || d.Values()[j] < 0 {
+			t.Fatal("The singular values should be non-negative and in descending order.")
+		}
+	}
+
+	if !equalApproximately(u.Transpose().Multiply(u), arrays.Identity(k).Matrix(), 1e-12) {
+		t.Fatal("The left singular vectors should be orthonormal.")
+	}
+
+	if !equalApproximately(v.Transpose().Multiply(v), arrays.Identity(k).Matrix(), 1e-12) {
+		t.Fatal("The right singular vectors should be orthonormal.")
+	}
+
+	s := dense.Zeros(k, k)
+	for j, value := range d.Values() {
+		s.Update(j, j, value)
+	}
+
+	if !equalApproximately(u.Multiply(s).Multiply(v.Transpose()), m, 1e-12) {
+		t.Fatal("The decomposition should reconstruct the matrix.")
+	}
+}
+
+func TestNewSVD(t *testing.T) {
+	m := dense.New(4, 3)(
+		3, 2, 2,
+		2, 3, -2,
+		1, 0, 1,
+		0, 4, 1,
+	)
+
+	d, err := NewSVD(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSVD(t, m, d)
+}
+
+func TestNewSVDForWideAndDeficientMatrices(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for _, shape := range [][]int{{3, 7}, {7, 3}, {5, 5}} {
+		rows, columns := shape[0], shape[1]
+
+		// The rank is two.
+		x := dense.Zeros(rows, 2)
+		y := dense.Zeros(2, columns)
+		for i := 0; i < rows; i++ {
+			x.Update(i, 0, r.NormFloat64())
+			x.Update(i, 1, r.NormFloat64())
+		}
+		for j := 0; j < columns; j++ {
+			y.Update(0, j, r.NormFloat64())
+			y.Update(1, j, r.NormFloat64())
+		}
+		m := x.Multiply(y).(*dense.Matrix)
+
+		d, err := NewSVD(m)
+		if err != nil {
+			t.Fatalf("An unexpected error occured: %s", err)
+		}
+
+		checkSVD(t, m, d)
+
+		if d.Values()[2] != 0 {
+			t.Fatal("The singular values beyond the rank should be zero.")
+		}
+	}
+}
+
+func TestNewSVDAtExtremeMagnitudes(t *testing.T) {
+	for _, scale := range []float64{1e200, 1e-170} {
+		for _, m := range []*dense.Matrix{
+			dense.New(2, 2)(1*scale, 0, 0, 2*scale),
+			dense.New(2, 2)(2*scale, 1*scale, 1*scale, 2*scale),
+		} {
+			d, err := NewSVD(m)
+			if err != nil {
+				t.Fatalf("An unexpected error occured: %s", err)
+			}
+
+			values := d.Values()
+			expected := []float64{2, 1}
+			if m.Get(0, 1) != 0 {
+				expected = []float64{3, 1}
+			}
+
+			for j, value := range values {
+				if math.Abs(value/scale-expected[j]) > 1e-12 {
+					t.Fatalf("The singular values should be %v times %v, but are %v.", expected, scale, values)
+				}
+			}
+
+			u, v := d.U(), d.V()
+
+			if !equalApproximately(u.Transpose().Multiply(u), arrays.Identity(2).Matrix(), 1e-12) {
+				t.Fatal("The left singular vectors should be orthonormal.")
+			}
+
+			if !equalApproximately(v.Transpose().Multiply(v), arrays.Identity(2).Matrix(), 1e-12) {
+				t.Fatal("The right singular vectors should be orthonormal.")
+			}
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// The maximum number of QL iterations spent on each eigenvalue.
+const symmetricIterations = 100
+
+/*
+"SymmetricEigen" is the eigendecomposition "m = v * diag(values) * v^T" of a symmetric matrix,
+where the eigenvalues are real and "v" is orthogonal.
+*/
+type SymmetricEigen struct {
+	values  []float64
+	vectors *arrays.Array
+}
+
+// Compute the eigenvalues and eigenvectors of the symmetric matrix "m".
+// Only the lower triangle of "m" is read.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When the QL iteration doesn't converge, an error will be returned.
+func NewSymmetricEigen(m types.Matrix) (*SymmetricEigen, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	v := arrays.Convert(m)
+	n := v.Rows()
+
+	d := make([]float64, n)
+	e := make([]float64, n)
+
+	tridiagonalize(v, d, e)
+
+	if err := diagonalize(v, d, e); err != nil {
+		return nil, err
+	}
+
+	s := &SymmetricEigen{
+		values:  d,
+		vectors: v,
+	}
+
+	return s, nil
+}
+
+// Return the eigenvalues in ascending order.
+func (s *SymmetricEigen) Values() []float64 {
+	values := make([]float64, len(s.values))
+	copy(values, s.values)
+
+	return values
+}
+
+// Return the orthogonal matrix whose k-th column is the eigenvector
+// for the k-th eigenvalue of "(*SymmetricEigen).Values".
+func (s *SymmetricEigen) Vectors() *dense.Matrix {
+	return s.vectors.Matrix()
+}
+
+// Reduce the symmetric array "v" to tridiagonal form with Householder reflections.
+// The diagonal and sub-diagonal elements are stored into "d" and "e",
+// and "v" is overwritten with the accumulated transformations.
+// This is based on the procedure "tred2" of EISPACK.
+func tridiagonalize(v *arrays.Array, d, e []float64) {
+	n := v.Rows()
+
+	for j := 0; j < n; j++ {
+		d[j] = v.Get(n-1, j)
+	}
+
+	for i := n - 1; i > 0; i-- {
+		scale := 0.0
+		h := 0.0
+
+		for k := 0; k < i; k++ {
+			scale += math.Abs(d[k])
+		}
+
+		if scale == 0 {
+			e[i] = d[i-1]
+
+			for j := 0; j < i; j++ {
+				d[j] = v.Get(i-1, j)
+				v.Update(i, j, 0)
+				v.Update(j, i, 0)
+			}
+		} else {
+			// Generate a Householder vector.
+			for k := 0; k < i; k++ {
+				d[k] /= scale
+				h += d[k] * d[k]
+			}
+
+			f := d[i-1]
+			g := math.Sqrt(h)
+			if f > 0 {
+				g = -g
+			}
+
+			e[i] = scale * g
+			h -= f * g
+			d[i-1] = f - g
+
+			for j := 0; j < i; j++ {
+				e[j] = 0
+			}
+
+			// Apply the similarity transformation to the remaining columns.
+			for j := 0; j < i; j++ {
+				f = d[j]
+				v.Update(j, i, f)
+				g = e[j] + v.Get(j, j)*f
+
+				for k := j + 1; k <= i-1; k++ {
+					g += v.Get(k, j) * d[k]
+					e[k] += v.Get(k, j) * f
+				}
+
+				e[j] = g
+			}
+
+			f = 0
+			for j := 0; j < i; j++ {
+				e[j] /= h
+				f += e[j] * d[j]
+			}
+
+			hh := f / (h + h)
+			for j := 0; j < i; j++ {
+				e[j] -= hh * d[j]
+			}
+
+			for j := 0; j < i; j++ {
+				f = d[j]
+				g = e[j]
+
+				for k := j; k <= i-1; k++ {
+					v.Update(k, j, v.Get(k, j)-(f*e[k]+g*d[k]))
+				}
+
+				d[j] = v.Get(i-1, j)
+				v.Update(i, j, 0)
+			}
+		}
+
+		d[i] = h
+	}
+
+	// Accumulate the transformations.
+	for i := 0; i < n-1; i++ {
+		v.Update(n-1, i, v.Get(i, i))
+		v.Update(i, i, 1)
+
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = v.Get(k, i+1) / h
+			}
+
+			for j := 0; j <= i; j++ {
+				g := 0.0
+				for k := 0; k <= i; k++ {
+					g += v.Get(k, i+1) * v.Get(k, j)
+				}
+
+				for k := 0; k <= i; k++ {
+					v.Update(k, j, v.Get(k, j)-g*d[k])
+				}
+			}
+		}
+
+		for k := 0; k <= i; k++ {
+			v.Update(k, i+1, 0)
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		d[j] = v.Get(n-1, j)
+		v.Update(n-1, j, 0)
+	}
+
+	v.Update(n-1, n-1, 1)
+	e[0] = 0
+}
+
+// Diagonalize the tridiagonal matrix given as "d" and "e" with the implicit QL iteration.
+// The eigenvalues are stored into "d" in ascending order,
+// and the transformations are accumulated into "v".
+// This is based on the procedure "tql2" of EISPACK.
+func diagonalize(v *arrays.Array, d, e []float64) error {
+	n := v.Rows()
+
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	f := 0.0
+	tst := 0.0
+
+	for l := 0; l < n; l++ {
+		// Look for a small sub-diagonal element.
+		tst = math.Max(tst, math.Abs(d[l])+math.Abs(e[l]))
+
+		m := l
+		for m < n-1 {
+			if math.Abs(e[m]) <= arrays.Epsilon*tst {
+				break
+			}
+			m++
+		}
+
+		for iterations := 0; m > l && math.Abs(e[l]) > arrays.Epsilon*tst; iterations++ {
+			if iterations >= symmetricIterations {
+				return errors.New(NotConvergedError)
+			}
+
+			// Compute an implicit shift.
+			g := d[l]
+			p := (d[l+1] - g) / (2 * e[l])
+			r := math.Hypot(p, 1)
+			if p < 0 {
+				r = -r
+			}
+
+			d[l] = e[l] / (p + r)
+			d[l+1] = e[l] * (p + r)
+			dl1 := d[l+1]
+			h := g - d[l]
+
+			for i := l + 2; i < n; i++ {
+				d[i] -= h
+			}
+			f += h
+
+			// Perform an implicit QL transformation.
+			p = d[m]
+			c, c2, c3 := 1.0, 1.0, 1.0
+			el1 := e[l+1]
+			s, s2 := 0.0, 0.0
+
+			for i := m - 1; i >= l; i-- {
+				c3 = c2
+				c2 = c
+				s2 = s
+				g = c * e[i]
+				h = c * p
+				r = math.Hypot(p, e[i])
+				e[i+1] = s * r
+				s = e[i] / r
+				c = p / r
+				p = c*d[i] - s*g
+				d[i+1] = h + s*(c*g+s*d[i])
+
+				for k := 0; k < n; k++ {
+					h = v.Get(k, i+1)
+					v.Update(k, i+1, s*v.Get(k, i)+c*h)
+					v.Update(k, i, c*v.Get(k, i)-s*h)
+				}
+			}
+
+			p = -s * s2 * c3 * el1 * e[l] / dl1
+			e[l] = s * p
+			d[l] = c * p
+		}
+
+		d[l] += f
+		e[l] = 0
+	}
+
+	// Sort the eigenvalues and the corresponding vectors.
+	for i := 0; i < n-1; i++ {
+		k := i
+		p := d[i]
+
+		for j := i + 1; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+
+		if k == i {
+			continue
+		}
+
+		d[k] = d[i]
+		d[i] = p
+
+		for j := 0; j < n; j++ {
+			p = v.Get(j, i)
+			v.Update(j, i, v.Get(j, k))
+			v.Update(j, k, p)
+		}
+	}
+
+	return nil
+}
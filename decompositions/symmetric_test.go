@@ -0,0 +1,65 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewSymmetricEigenComputesEigenpairs(t *testing.T) {
+	m := dense.New(3, 3)(
+		2, -1, 0,
+		-1, 2, -1,
+		0, -1, 2,
+	)
+
+	s, err := NewSymmetricEigen(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2}
+
+	for i, value := range s.Values() {
+		if math.Abs(value-expected[i]) > 1e-12 {
+			t.Fatalf("The eigenvalues should be %v, but are %v.", expected, s.Values())
+		}
+	}
+
+	v := s.Vectors()
+	d := dense.Zeros(3, 3)
+	for i, value := range s.Values() {
+		d.Update(i, i, value)
+	}
+
+	if !equalApproximately(v.Multiply(d).Multiply(v.Transpose()), m, 1e-12) {
+		t.Fatal("The product v * diag(values) * v^T should equal to the original matrix.")
+	}
+}
+
+func TestNewSymmetricEigenCreatesOrthogonalVectors(t *testing.T) {
+	m := dense.New(4, 4)(
+		4, 1, -2, 2,
+		1, 2, 0, 1,
+		-2, 0, 3, -2,
+		2, 1, -2, -1,
+	)
+
+	s, err := NewSymmetricEigen(m)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	v := s.Vectors()
+	i := dense.New(4, 4)(
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	)
+
+	if !equalApproximately(v.Transpose().Multiply(v), i, 1e-12) {
+		t.Fatal("The eigenvectors should be orthonormal.")
+	}
+}
@@ -0,0 +1,113 @@
+package decompositions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"TruncatedSVD" is the "k" largest singular triplets "m * v = u * diag(values)".
+They are computed with the implicitly restarted Lanczos method
+applied to "m^T * m" or "m * m^T", whichever is smaller,
+so "m" is accessed only through "(Matrix).Multiply" of itself and its transpose.
+For a zero singular value, the singular vector of the larger dimension is zero.
+*/
+type TruncatedSVD struct {
+	values    []float64
+	u         *arrays.Array
+	v         *arrays.Array
+	residuals []float64
+}
+
+// Compute the "k" largest singular values and the corresponding singular vectors of "m".
+// The starting vector of settings has the smaller dimension of "m".
+// When "k" is not in [1, min(m.Rows(), m.Columns())], validates.OUT_OF_RANGE_PANIC will be caused.
+// When the iteration doesn't converge within the maximum number of restarts, an error will be returned.
+func NewTruncatedSVD(m types.Matrix, k int, s *KrylovSettings) (*TruncatedSVD, error) {
+	rows, columns := m.Shape()
+	t := m.Transpose()
+
+	// Compute the singular vectors of the smaller side as the eigenvectors of the Gram matrix.
+	first, second := t, m
+	if rows >= columns {
+		first, second = m, t
+	}
+
+	operator := func(x []float64) []float64 {
+		return multiplyVector(second, multiplyVector(first, x))
+	}
+
+	l, err := newLanczos(operator, second.Rows(), k, LargestReal, s)
+	if err != nil {
+		return nil, err
+	}
+
+	small := l.vectors
+	large := arrays.Zeros(first.Rows(), k)
+
+	d := &TruncatedSVD{
+		values:    make([]float64, k),
+		residuals: make([]float64, k),
+	}
+
+	x := make([]float64, small.Rows())
+
+	for j, value := range l.values {
+		sigma := math.Sqrt(math.Max(value, 0))
+		d.values[j] = sigma
+		d.residuals[j] = l.residuals[j]
+
+		if sigma == 0 {
+			continue
+		}
+
+		// The other singular vector is "first * x / sigma",
+		// and the residual of it is that of the eigenpair divided by "sigma".
+		for i := range x {
+			x[i] = small.Get(i, j)
+		}
+
+		for i, element := range multiplyVector(first, x) {
+			large.Update(i, j, element/sigma)
+		}
+
+		d.residuals[j] /= sigma
+	}
+
+	if rows >= columns {
+		d.u, d.v = large, small
+	} else {
+		d.u, d.v = small, large
+	}
+
+	return d, nil
+}
+
+// Return the singular values in descending order.
+func (d *TruncatedSVD) Values() []float64 {
+	values := make([]float64, len(d.values))
+	copy(values, d.values)
+
+	return values
+}
+
+// Return the matrix whose columns are the left singular vectors.
+func (d *TruncatedSVD) U() *dense.Matrix {
+	return d.u.Matrix()
+}
+
+// Return the matrix whose columns are the right singular vectors.
+func (d *TruncatedSVD) V() *dense.Matrix {
+	return d.v.Matrix()
+}
+
+// Return the estimates of residual norm "|m^T * u - value * v|" for the singular triplets.
+func (d *TruncatedSVD) Residuals() []float64 {
+	residuals := make([]float64, len(d.residuals))
+	copy(residuals, d.residuals)
+
+	return residuals
+}
@@ -0,0 +1,64 @@
+package decompositions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNewTruncatedSVDComputesLargestTriplets(t *testing.T) {
+	for _, m := range []*dense.Matrix{
+		dense.New(4, 3)(
+			3, 1, 0,
+			1, 4, 2,
+			0, 2, 5,
+			1, 0, 1,
+		),
+		dense.New(3, 4)(
+			3, 1, 0, 1,
+			1, 4, 2, 0,
+			0, 2, 5, 1,
+		),
+	} {
+		rows, columns := m.Shape()
+
+		d, err := NewTruncatedSVD(m, 2, nil)
+		if err != nil {
+			t.Fatalf("An unexpected error occured: %s", err)
+		}
+
+		g, err := NewSymmetricEigen(m.Transpose().Multiply(m))
+		if err != nil {
+			t.Fatalf("An unexpected error occured: %s", err)
+		}
+		gram := g.Values()
+
+		u, v := d.U(), d.V()
+		if u.Rows() != rows || v.Rows() != columns || u.Columns() != 2 || v.Columns() != 2 {
+			t.Fatal("The singular vectors should have the shapes of the matrix.")
+		}
+
+		for k, value := range d.Values() {
+			expected := math.Sqrt(gram[len(gram)-1-k])
+			if math.Abs(value-expected) > 1e-10 {
+				t.Fatalf("The %d-th singular value should be %v, but is %v.", k, expected, value)
+			}
+
+			for i := 0; i < rows; i++ {
+				e := -value * u.Get(i, k)
+				for j := 0; j < columns; j++ {
+					e += m.Get(i, j) * v.Get(j, k)
+				}
+
+				if math.Abs(e) > 1e-10 {
+					t.Fatal("The product m * v should equal to u * diag(values).")
+				}
+			}
+
+			if d.Residuals()[k] > 1e-8 {
+				t.Fatalf("The %d-th residual should be small, but is %v.", k, d.Residuals()[k])
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package decompositions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Compute "(a + u * v^T)^-1" from the explicit inverse "a^-1" with the Sherman-Morrison formula,
+// where "u" and "v" are column vectors.
+// When "inverse" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// when "u" or "v" is not a column vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when their sizes are different, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When the updated matrix is singular, an error will be returned.
+func ShermanMorrison(inverse, u, v types.Matrix) (*dense.Matrix, error) {
+	validates.ShapeShouldBeColumnVector(u)
+	validates.ShapeShouldBeColumnVector(v)
+
+	return Woodbury(inverse, u, dense.New(1, 1)(1), v)
+}
+
+// Compute "(a + s * u * u^T)^-1" from the explicit inverse "a^-1" of symmetric positive definite "a",
+// where "u" is a column vector and a negative "s" downdates "a".
+// When "inverse" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// when "u" is not a column vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when the sizes are different, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When the updated matrix is singular or loses the positive definiteness, an error will be returned.
+func SymmetricShermanMorrison(inverse, u types.Matrix, s float64) (*dense.Matrix, error) {
+	validates.ShapeShouldBeColumnVector(u)
+
+	return SymmetricWoodbury(inverse, u, dense.New(1, 1)(s))
+}
+
+// Compute "(a + u * c * v^T)^-1" from the explicit inverse "a^-1" with the Woodbury identity
+// "a^-1 - a^-1 * u * (c^-1 + v^T * a^-1 * u)^-1 * v^T * a^-1",
+// where "u" and "v" are "n x k" and "c" is "k x k".
+// When "inverse" or "c" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when the shapes are inconsistent, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When "c" or the updated matrix is singular, an error will be returned.
+func Woodbury(inverse, u, c, v types.Matrix) (*dense.Matrix, error) {
+	return woodbury(inverse, u, c, v, false)
+}
+
+// Compute "(a + u * c * u^T)^-1" from the explicit inverse "a^-1" of symmetric positive definite "a"
+// with the Woodbury identity, where "u" is "n x k" and "c" is symmetric "k x k".
+// When "inverse" or "c" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when the shapes are inconsistent, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When "c" or the updated matrix is singular or the update loses the positive definiteness,
+// an error will be returned.
+func SymmetricWoodbury(inverse, u, c types.Matrix) (*dense.Matrix, error) {
+	return woodbury(inverse, u, c, u, true)
+}
+
+// Compute "(a + u * c * v^T)^-1" with the Woodbury identity,
+// checking that the update keeps the positive definiteness when "positiveDefinite" is true.
+func woodbury(inverse, u, c, v types.Matrix, positiveDefinite bool) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(inverse)
+	validates.ShapeShouldBeSquare(c)
+	validates.ShapeShouldBeMultipliable(inverse, u)
+	validates.ShapeShouldBeMultipliable(u, c)
+	validates.ShapeShouldBeMultipliable(inverse, v)
+	validates.ShapeShouldBeMultipliable(c, v.Transpose())
+
+	ai := arrays.Convert(inverse)
+	ua := arrays.Convert(u)
+	va := arrays.Convert(v)
+	ca := arrays.Convert(c)
+
+	ci, err := invert(ca, ca.MaxAbs())
+	if err != nil {
+		return nil, err
+	}
+
+	// The capacitance matrix "c^-1 + v^T * a^-1 * u".
+	aiu := ai.Multiply(ua)
+	vtai := va.Transpose().Multiply(ai)
+
+	s := va.Transpose().Multiply(aiu)
+	scale := math.Max(s.MaxAbs(), ci.MaxAbs())
+	for i, element := range ci.Elements() {
+		s.Elements()[i] += element
+	}
+
+	if positiveDefinite {
+		if err := keepsPositiveDefiniteness(s, ca); err != nil {
+			return nil, err
+		}
+	}
+
+	si, err := invert(s, scale)
+	if err != nil {
+		return nil, err
+	}
+
+	correction := aiu.Multiply(si).Multiply(vtai)
+	for i, element := range correction.Elements() {
+		ai.Elements()[i] -= element
+	}
+
+	return ai.Matrix(), nil
+}
+
+// Check that "a + u * c * u^T" is positive definite for symmetric positive definite "a",
+// which holds if and only if the capacitance matrix "s" and "c" have the same number of positive eigenvalues
+// by the inertia theorem of Haynsworth.
+func keepsPositiveDefiniteness(s, c *arrays.Array) error {
+	sv, err := NewSymmetricEigen(s.Matrix())
+	if err != nil {
+		return err
+	}
+
+	cv, err := NewSymmetricEigen(c.Matrix())
+	if err != nil {
+		return err
+	}
+
+	if countPositive(sv.Values()) != countPositive(cv.Values()) {
+		return errors.New(NotPositiveDefiniteError)
+	}
+
+	return nil
+}
+
+func countPositive(values []float64) int {
+	scale := 0.0
+	for _, value := range values {
+		scale = math.Max(scale, math.Abs(value))
+	}
+
+	count := 0
+	for _, value := range values {
+		if value > float64(len(values))*arrays.Epsilon*scale {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Return the inverse of the small square array "a" with the LU decomposition with partial pivoting.
+// When a pivot is negligible compared with "scale", "a" is regarded as singular and an error will be returned.
+func invert(a *arrays.Array, scale float64) (*arrays.Array, error) {
+	d := arrays.NewLU(a, float64(a.Rows())*arrays.Epsilon*scale)
+	if d.Singular() {
+		return nil, errors.New(SingularError)
+	}
+
+	return d.Inverse(), nil
+}
@@ -0,0 +1,127 @@
+package decompositions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestShermanMorrisonUpdatesInverse(t *testing.T) {
+	// "a = diag(2, 4, 5)".
+	inverse := dense.New(3, 3)(
+		0.5, 0, 0,
+		0, 0.25, 0,
+		0, 0, 0.2,
+	)
+	u := dense.New(3, 1)(1, 2, 0)
+	v := dense.New(3, 1)(0, 1, -1)
+
+	updated, err := ShermanMorrison(inverse, u, v)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	a := dense.New(3, 3)(
+		2, 0, 0,
+		0, 4, 0,
+		0, 0, 5,
+	)
+	a.Add(u.Multiply(v.Transpose()))
+
+	if !equalApproximately(updated.Multiply(a), dense.New(3, 3)(1, 0, 0, 0, 1, 0, 0, 0, 1), 1e-12) {
+		t.Fatal("The result should be the inverse of a + u * v^T.")
+	}
+}
+
+func TestShermanMorrisonFailsForSingularUpdate(t *testing.T) {
+	inverse := dense.New(2, 2)(
+		1, 0,
+		0, 1,
+	)
+	u := dense.New(2, 1)(1, 0)
+	v := dense.New(2, 1)(-1, 0)
+
+	if _, err := ShermanMorrison(inverse, u, v); err == nil || err.Error() != SingularError {
+		t.Fatalf("The singular update should cause %s.", SingularError)
+	}
+}
+
+func TestWoodburyUpdatesSymmetricInverse(t *testing.T) {
+	a := dense.New(3, 3)(
+		4, 1, 0,
+		1, 3, 1,
+		0, 1, 2,
+	)
+	inverse := dense.New(3, 3)(
+		5, -2, 1,
+		-2, 8, -4,
+		1, -4, 11,
+	).Scalar(1.0 / 18)
+	u := dense.New(3, 2)(
+		1, 0,
+		0, 1,
+		1, 1,
+	)
+	c := dense.New(2, 2)(
+		0.5, 0.1,
+		0.1, -0.2,
+	)
+
+	updated, err := SymmetricWoodbury(inverse, u, c)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	a.Add(u.Multiply(c).Multiply(u.Transpose()))
+
+	if !equalApproximately(updated.Multiply(a), dense.New(3, 3)(1, 0, 0, 0, 1, 0, 0, 0, 1), 1e-12) {
+		t.Fatal("The result should be the inverse of a + u * c * u^T.")
+	}
+}
+
+func TestWoodburyFailsForLossOfPositiveDefiniteness(t *testing.T) {
+	inverse := dense.New(2, 2)(
+		0.5, 0,
+		0, 0.5,
+	)
+	u := dense.New(2, 1)(1, 0)
+
+	// "diag(2, 2) - 3 * u * u^T" is indefinite but not singular.
+	if _, err := SymmetricWoodbury(inverse, u, dense.New(1, 1)(-3)); err == nil || err.Error() != NotPositiveDefiniteError {
+		t.Fatalf("The update losing positive definiteness should cause %s.", NotPositiveDefiniteError)
+	}
+
+	// A downdate keeping positive definiteness is allowed.
+	if _, err := SymmetricShermanMorrison(inverse, u, -1); err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+}
+
+func TestShermanMorrisonUpdatesIndefiniteInverse(t *testing.T) {
+	inverse := dense.New(2, 2)(
+		-2, 0,
+		0, -2,
+	)
+	u := dense.New(2, 1)(1, 0)
+
+	// "a + u * u^T = diag(0.5, -0.5)" is indefinite but not singular.
+	updated, err := ShermanMorrison(inverse, u, u)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(updated, dense.New(2, 2)(2, 0, 0, -2), 1e-12) {
+		t.Fatal("The result should be the inverse of a + u * u^T.")
+	}
+}
+
+func TestWoodburyCausesPanicForInconsistentShape(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_MULTIPLIABLE_PANIC {
+			t.Fatalf("The inconsistent shapes should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+		}
+	}()
+
+	Woodbury(dense.Zeros(2, 2), dense.Zeros(2, 1), dense.Zeros(2, 2), dense.Zeros(2, 2))
+}
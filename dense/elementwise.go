@@ -0,0 +1,164 @@
+package dense
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/visitors"
+)
+
+func init() {
+	visitors.Dense = visit
+}
+
+func (m *Matrix) Apply(f func(element float64, row, column int) float64) types.Matrix {
+	m.each(func(index, row, column int) {
+		m.elements[index] = f(m.elements[index], row, column)
+	})
+
+	return m
+}
+
+func (m *Matrix) ApplyNonZeros(f func(element float64, row, column int) float64) types.Matrix {
+	m.each(func(index, row, column int) {
+		if element := m.elements[index]; element != 0 {
+			m.elements[index] = f(element, row, column)
+		}
+	})
+
+	return m
+}
+
+func (m *Matrix) Map(f func(element float64, row, column int) float64) types.Matrix {
+	r := Zeros(m.Shape())
+
+	m.each(func(index, row, column int) {
+		r.elements[row*r.base.Columns()+column] = f(m.elements[index], row, column)
+	})
+
+	return r
+}
+
+func (m *Matrix) MapNonZeros(f func(element float64, row, column int) float64) types.Matrix {
+	r := Zeros(m.Shape())
+
+	m.each(func(index, row, column int) {
+		if element := m.elements[index]; element != 0 {
+			r.elements[row*r.base.Columns()+column] = f(element, row, column)
+		}
+	})
+
+	return r
+}
+
+func (m *Matrix) Hadamard(n types.Matrix) types.Matrix {
+	validates.ShapeShouldBeSame(m, n)
+
+	get := m.getterOf(n)
+
+	m.each(func(index, row, column int) {
+		m.elements[index] *= get(row, column)
+	})
+
+	return m
+}
+
+func (m *Matrix) Divide(n types.Matrix) types.Matrix {
+	validates.ShapeShouldBeSame(m, n)
+
+	get := m.getterOf(n)
+
+	m.each(func(index, row, column int) {
+		m.elements[index] /= get(row, column)
+	})
+
+	return m
+}
+
+func (m *Matrix) Pow(p float64) types.Matrix {
+	m.each(func(index, row, column int) {
+		m.elements[index] = math.Pow(m.elements[index], p)
+	})
+
+	return m
+}
+
+func (m *Matrix) Abs() types.Matrix {
+	m.each(func(index, row, column int) {
+		m.elements[index] = math.Abs(m.elements[index])
+	})
+
+	return m
+}
+
+// Call "f" for each element of "m" when "m" is a dense matrix,
+// and return false when "m" is not a dense matrix.
+func visit(m types.Matrix, f func(element float64, row, column int)) bool {
+	d, isDense := m.(*Matrix)
+
+	if !isDense {
+		return false
+	}
+
+	d.each(func(index, row, column int) {
+		f(d.elements[index], row, column)
+	})
+
+	return true
+}
+
+// Call "f" with the index of "elements", the row and the column for each element of the view.
+// The elements are visited in the order of "elements" to access the memory sequentially.
+func (m *Matrix) each(f func(index, row, column int)) {
+	rows, columns := m.view.Rows(), m.view.Columns()
+
+	for i := 0; i < rows; i++ {
+		start := (i+m.offset.Row())*m.base.Columns() + m.offset.Column()
+
+		for j := 0; j < columns; j++ {
+			row, column := m.rewriter.Rewrite(i, j)
+			f(start+j, row, column)
+		}
+	}
+}
+
+// Return the index of "elements" for the element at "row" and "column" without validation.
+func (m *Matrix) index(row, column int) int {
+	row, column = m.rewriter.Rewrite(row, column)
+
+	return (row+m.offset.Row())*m.base.Columns() + column + m.offset.Column()
+}
+
+// Return the function to read elements of "n",
+// which reads "elements" directly when "n" is a dense matrix.
+// When "n" shares "elements" with the receiver, the elements are copied in advance
+// so that updating the receiver doesn't change the elements read from "n".
+func (m *Matrix) getterOf(n types.Matrix) func(row, column int) float64 {
+	d, isDense := n.(*Matrix)
+
+	if !isDense {
+		return n.Get
+	}
+
+	if m.shares(d) {
+		columns := d.Columns()
+		x := d.rowMajor()
+
+		return func(row, column int) float64 {
+			return x[row*columns+column]
+		}
+	}
+
+	return func(row, column int) float64 {
+		return d.elements[d.index(row, column)]
+	}
+}
+
+// Check whether the receiver and "n" have the same underlying storage.
+// Slices of the same array end at the same element of their capacity.
+func (m *Matrix) shares(n *Matrix) bool {
+	x, y := m.elements[:cap(m.elements)], n.elements[:cap(n.elements)]
+
+	return &x[len(x)-1] == &y[len(y)-1]
+}
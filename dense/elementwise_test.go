@@ -0,0 +1,221 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/visitors"
+)
+
+func TestApplyUpdatesElementsOfView(t *testing.T) {
+	m := New(3, 3)(
+		0, 1, 2,
+		3, 4, 5,
+		6, 7, 8,
+	)
+
+	f := func(element float64, row, column int) float64 {
+		return element + float64(10*row+column)
+	}
+
+	if r := m.View(1, 1, 2, 2).Transpose().Apply(f); !r.Equal(New(2, 2)(4, 8, 15, 19)) {
+		t.Fatal("Apply should pass the indexes of the transposed view.")
+	}
+
+	r := New(3, 3)(
+		0, 1, 2,
+		3, 4, 15,
+		6, 8, 19,
+	)
+
+	if !m.Equal(r) {
+		t.Fatal("Apply should update only the elements of the view.")
+	}
+}
+
+func TestApplyNonZerosSkipsZeros(t *testing.T) {
+	m := New(2, 2)(
+		0, 1,
+		2, 0,
+	)
+
+	count := 0
+	f := func(element float64, row, column int) float64 {
+		count++
+		return -element
+	}
+
+	if r := m.ApplyNonZeros(f); r != m || count != 2 || !m.Equal(New(2, 2)(0, -1, -2, 0)) {
+		t.Fatal("ApplyNonZeros should apply the function only to non-zero elements in place.")
+	}
+}
+
+func TestMapCreatesNewMatrix(t *testing.T) {
+	m := New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	f := func(element float64, row, column int) float64 {
+		return element * 2
+	}
+
+	r := m.Transpose().Map(f)
+
+	if !r.Equal(New(3, 2)(0, 6, 2, 8, 4, 10)) {
+		t.Fatal("Map should create the matrix with the results of the function.")
+	}
+
+	if m.Get(1, 2) != 5 {
+		t.Fatal("Map should keep the receiver.")
+	}
+
+	r.Update(0, 0, 1)
+	if m.Get(0, 0) != 0 {
+		t.Fatal("Map should not share the elements with the receiver.")
+	}
+}
+
+func TestMapNonZerosSkipsZeros(t *testing.T) {
+	m := New(2, 2)(
+		0, 1,
+		2, 0,
+	).View(0, 0, 2, 1)
+
+	count := 0
+	f := func(element float64, row, column int) float64 {
+		count++
+		return element + 1
+	}
+
+	if r := m.MapNonZeros(f); count != 1 || !r.Equal(New(2, 1)(0, 3)) {
+		t.Fatal("MapNonZeros should apply the function only to non-zero elements.")
+	}
+}
+
+func TestHadamardMultipliesElementWise(t *testing.T) {
+	m := New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	n := New(3, 2)(
+		1, 0,
+		-1, 2,
+		3, 0.5,
+	).Transpose()
+
+	if r := m.Hadamard(n); r != m || !m.Equal(New(2, 3)(1, -2, 9, 0, 10, 3)) {
+		t.Fatal("Hadamard should multiply the receiver by the argument element-wise.")
+	}
+}
+
+func TestDivideDividesElementWise(t *testing.T) {
+	m := New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if r := m.Divide(New(2, 2)(2, 4, 1, 8)); r != m || !m.Equal(New(2, 2)(0.5, 0.5, 3, 0.5)) {
+		t.Fatal("Divide should divide the receiver by the argument element-wise.")
+	}
+}
+
+func TestHadamardReadsArgumentSharingElementsBeforeUpdate(t *testing.T) {
+	m := New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if m.Hadamard(m.Transpose()); !m.Equal(New(2, 2)(1, 6, 6, 16)) {
+		t.Fatal("Hadamard should read the transpose of the receiver before updating the receiver.")
+	}
+}
+
+func TestDivideReadsArgumentSharingElementsBeforeUpdate(t *testing.T) {
+	m := New(2, 2)(
+		1, 2,
+		4, 8,
+	)
+
+	if m.Divide(m.Transpose()); !m.Equal(New(2, 2)(1, 0.5, 2, 1)) {
+		t.Fatal("Divide should read the transpose of the receiver before updating the receiver.")
+	}
+}
+
+func TestHadamardCausesPanicForDifferentShapeMatrices(t *testing.T) {
+	defer func() {
+		if r := recover(); r != validates.DIFFERENT_SIZE_PANIC {
+			t.Fatalf("The shape of matrices should be same, but causes %v.", r)
+		}
+	}()
+
+	Zeros(2, 3).Hadamard(Zeros(3, 2))
+}
+
+func TestDivideCausesPanicForDifferentShapeMatrices(t *testing.T) {
+	defer func() {
+		if r := recover(); r != validates.DIFFERENT_SIZE_PANIC {
+			t.Fatalf("The shape of matrices should be same, but causes %v.", r)
+		}
+	}()
+
+	Zeros(2, 3).Divide(Zeros(2, 2))
+}
+
+func TestPowRaisesElements(t *testing.T) {
+	m := New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if r := m.Row(1).Pow(2); !r.Equal(New(1, 2)(9, 16)) || !m.Equal(New(2, 2)(1, 2, 9, 16)) {
+		t.Fatal("Pow should raise the elements of the view.")
+	}
+}
+
+func TestAbsReplacesElementsWithAbsoluteValues(t *testing.T) {
+	m := New(2, 2)(
+		-1, 2,
+		0, -4,
+	)
+
+	if r := m.Abs(); r != m || !m.Equal(New(2, 2)(1, 2, 0, 4)) {
+		t.Fatal("Abs should replace the elements with the absolute values.")
+	}
+}
+
+func TestVisitVisitsAllElementsOfView(t *testing.T) {
+	m := New(3, 3)(
+		0, 1, 2,
+		3, 4, 5,
+		6, 7, 8,
+	).View(0, 1, 3, 2).Transpose()
+
+	visited := Zeros(2, 3)
+	count := 0
+
+	isDense := visitors.Dense(m, func(element float64, row, column int) {
+		visited.Update(row, column, element)
+		count++
+	})
+
+	if !isDense || count != 6 || !visited.Equal(m) {
+		t.Fatal("The hook should visit all the elements of the view once.")
+	}
+}
+
+func TestVisitReturnsFalseForNonDenseMatrix(t *testing.T) {
+	isDense := visitors.Dense(nonDense{Zeros(2, 2)}, func(element float64, row, column int) {
+		t.Fatal("The hook shouldn't visit elements of a non-dense matrix.")
+	})
+
+	if isDense {
+		t.Fatal("The hook should return false for a non-dense matrix.")
+	}
+}
+
+// "nonDense" hides the dense matrix from type assertions.
+type nonDense struct {
+	*Matrix
+}
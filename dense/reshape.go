@@ -0,0 +1,81 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create a "rows x columns" matrix with the elements of the receiver in row-major order.
+// The result shares the elements with the receiver
+// when they are contiguous in the underlying storage, and has a copy of them otherwise.
+// When "rows" and "columns" is not positive,
+// validates.NON_POSITIVE_SIZE_PANIC will be caused.
+// When the product of "rows" and "columns" doesn't equal to the number of elements,
+// validates.INVALID_ELEMENTS_PANIC will be caused.
+func (m *Matrix) Reshape(rows, columns int) *Matrix {
+	validates.ShapeShouldBePositive(rows, columns)
+
+	size := m.view.Rows() * m.view.Columns()
+
+	if rows*columns != size {
+		panic(validates.INVALID_ELEMENTS_PANIC)
+	}
+
+	if !m.isContiguous() {
+		return New(rows, columns)(m.rowMajor()...)
+	}
+
+	start := m.offset.Row()*m.base.Columns() + m.offset.Column()
+	shape := types.NewShape(rows, columns)
+
+	n := &Matrix{
+		initialized: true,
+		base:        shape,
+		view:        shape,
+		offset:      types.NewIndex(0, 0),
+		elements:    m.elements[start : start+size],
+		rewriter:    rewriters.Reflect(),
+	}
+
+	return n
+}
+
+// Create a row vector with the elements of the receiver
+// in row-major order for "rowMajor" or in column-major order otherwise.
+// The result shares the elements with the receiver as same as "(*Matrix).Reshape".
+func (m *Matrix) Flatten(rowMajor bool) *Matrix {
+	size := m.view.Rows() * m.view.Columns()
+
+	if rowMajor {
+		return m.Reshape(1, size)
+	}
+
+	return m.Transpose().(*Matrix).Reshape(1, size)
+}
+
+// Check whether the elements in row-major order are contiguous in the underlying storage.
+// The rows of view are contiguous when they have the full width of base,
+// and a transposed matrix is read in the order of storage only when the view is a vector.
+func (m *Matrix) isContiguous() bool {
+	rows, columns := m.view.Rows(), m.view.Columns()
+	contiguous := rows == 1 || columns == m.base.Columns()
+
+	if m.rewriter == rewriters.Reverse() {
+		return contiguous && (rows == 1 || columns == 1)
+	}
+
+	return contiguous
+}
+
+// Return the elements of the receiver in row-major order.
+func (m *Matrix) rowMajor() []float64 {
+	columns := m.Columns()
+	x := make([]float64, m.view.Rows()*m.view.Columns())
+
+	m.each(func(index, row, column int) {
+		x[row*columns+column] = m.elements[index]
+	})
+
+	return x
+}
@@ -0,0 +1,118 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestReshapeSharesContiguousElements(t *testing.T) {
+	m := New(3, 4)(
+		0, 1, 2, 3,
+		4, 5, 6, 7,
+		8, 9, 10, 11,
+	)
+
+	r := m.View(1, 0, 2, 4).(*Matrix).Reshape(4, 2)
+
+	if !r.Equal(New(4, 2)(4, 5, 6, 7, 8, 9, 10, 11)) {
+		t.Fatal("Reshape should keep the elements in row-major order.")
+	}
+
+	r.Update(0, 0, -1)
+	if m.Get(1, 0) != -1 {
+		t.Fatal("Reshape should share the elements of the contiguous view.")
+	}
+
+	r = m.Row(2).(*Matrix).Reshape(2, 2)
+	r.Update(1, 1, -2)
+	if m.Get(2, 3) != -2 {
+		t.Fatal("Reshape should share the elements of the row view.")
+	}
+}
+
+func TestReshapeCopiesNonContiguousElements(t *testing.T) {
+	m := New(3, 3)(
+		0, 1, 2,
+		3, 4, 5,
+		6, 7, 8,
+	)
+
+	r := m.View(0, 1, 2, 2).(*Matrix).Reshape(1, 4)
+
+	if !r.Equal(New(1, 4)(1, 2, 4, 5)) {
+		t.Fatal("Reshape should keep the elements of the view in row-major order.")
+	}
+
+	r.Update(0, 0, -1)
+	if m.Get(0, 1) != 1 {
+		t.Fatal("Reshape should copy the elements of the non-contiguous view.")
+	}
+}
+
+func TestReshapeRespectsTranspose(t *testing.T) {
+	m := New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	r := m.Transpose().(*Matrix).Reshape(2, 3)
+
+	if !r.Equal(New(2, 3)(0, 3, 1, 4, 2, 5)) {
+		t.Fatal("Reshape should read the transposed matrix in its row-major order.")
+	}
+
+	r.Update(0, 0, -1)
+	if m.Get(0, 0) != 0 {
+		t.Fatal("Reshape should copy the elements of the transposed matrix.")
+	}
+
+	v := m.Row(1).Transpose().(*Matrix).Reshape(1, 3)
+	v.Update(0, 2, -5)
+	if m.Get(1, 2) != -5 {
+		t.Fatal("Reshape should share the elements of the transposed vector.")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	m := New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	if !m.Flatten(true).Equal(New(1, 6)(0, 1, 2, 3, 4, 5)) {
+		t.Fatal("Flatten should return the elements in row-major order.")
+	}
+
+	if !m.Flatten(false).Equal(New(1, 6)(0, 3, 1, 4, 2, 5)) {
+		t.Fatal("Flatten should return the elements in column-major order.")
+	}
+
+	if !m.Transpose().(*Matrix).Flatten(false).Equal(New(1, 6)(0, 1, 2, 3, 4, 5)) {
+		t.Fatal("Flatten should return the column-major order of the transpose.")
+	}
+
+	m.Flatten(true).Update(0, 4, -4)
+	if m.Get(1, 1) != -4 {
+		t.Fatal("Flatten should share the elements of the contiguous matrix.")
+	}
+}
+
+func TestReshapeCausesPanicForDifferentNumberOfElements(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.INVALID_ELEMENTS_PANIC {
+			t.Fatalf("A different number of elements should cause %s.", validates.INVALID_ELEMENTS_PANIC)
+		}
+	}()
+
+	Zeros(2, 3).Reshape(4, 2)
+}
+
+func TestHadamardReadsReshapedViewSharingElementsBeforeUpdate(t *testing.T) {
+	m := New(1, 3)(2, 3, 4)
+	r := m.View(0, 1, 1, 2).(*Matrix).Reshape(2, 1)
+
+	if r.Hadamard(m.View(0, 0, 1, 2).Transpose()); !r.Equal(New(2, 1)(6, 12)) {
+		t.Fatal("Hadamard should read the argument sharing the elements before updating the receiver.")
+	}
+}
@@ -0,0 +1,108 @@
+package functions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// The maximum degree of the Padé approximant used by "Exp".
+const maxPadeDegree = 20
+
+// Compute the matrix exponential of the square matrix "m"
+// with the diagonal Padé approximant and scaling and squaring.
+// The degree of approximant is the smallest one whose error bound is lower than the tolerance.
+// This is based on Algorithm 9.3.1 of "Matrix Computations" by Golub and Van Loan.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When "m" has a NaN or infinite element, an error will be returned.
+func Exp(m types.Matrix, s *Settings) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	tolerance, _ := s.values()
+
+	a := arrays.Convert(m)
+	if err := validateFinite(a); err != nil {
+		return nil, err
+	}
+
+	e, err := exp(a, tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Matrix(), nil
+}
+
+func exp(a *arrays.Array, tolerance float64) (*arrays.Array, error) {
+	n := a.Rows()
+
+	// Scale "a" so that its norm is not greater than 1/2.
+	squarings := 0
+	if norm := norm1(a); norm > 0 {
+		squarings = maxInt(0, int(math.Floor(math.Log2(norm)))+2)
+	}
+	a = combine(math.Ldexp(1, -squarings), a, 0, a)
+
+	q := padeDegree(tolerance)
+
+	numerator := arrays.Identity(n)
+	denominator := arrays.Identity(n)
+	x := arrays.Identity(n)
+	c := 1.0
+
+	for k := 1; k <= q; k++ {
+		c *= float64(q-k+1) / float64((2*q-k+1)*k)
+		x = a.Multiply(x)
+
+		numerator = combine(1, numerator, c, x)
+		if k%2 == 0 {
+			denominator = combine(1, denominator, c, x)
+		} else {
+			denominator = combine(1, denominator, -c, x)
+		}
+	}
+
+	f, _, err := solve(denominator, numerator)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < squarings; i++ {
+		f = f.Multiply(f)
+	}
+
+	return f, nil
+}
+
+// Return the smallest degree "q" such that the relative error bound
+// "2^(3 - 2q) * (q!)^2 / ((2q)! * (2q + 1)!)" of the Padé approximant is lower than "tolerance".
+func padeDegree(tolerance float64) int {
+	for q := 1; q < maxPadeDegree; q++ {
+		bound := math.Pow(2, float64(3-2*q))
+
+		for k := 1; k <= q; k++ {
+			bound *= float64(k) / float64(q+k)
+		}
+
+		for k := 1; k <= 2*q+1; k++ {
+			bound /= float64(k)
+		}
+
+		if bound <= tolerance {
+			return q
+		}
+	}
+
+	return maxPadeDegree
+}
+
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+
+	return y
+}
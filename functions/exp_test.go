@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestExpComputesRotation(t *testing.T) {
+	theta := 10.0
+	m := dense.New(2, 2)(
+		0, -theta,
+		theta, 0,
+	)
+
+	e, err := Exp(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := dense.New(2, 2)(
+		math.Cos(theta), -math.Sin(theta),
+		math.Sin(theta), math.Cos(theta),
+	)
+
+	if !equalApproximately(e, expected, 1e-13) {
+		t.Fatal("The exponential of skew-symmetric matrix should be the rotation.")
+	}
+}
+
+func TestExpComputesJordanBlock(t *testing.T) {
+	m := dense.New(3, 3)(
+		2, 1, 0,
+		0, 2, 1,
+		0, 0, 2,
+	)
+
+	e, err := Exp(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	c := math.Exp(2)
+	expected := dense.New(3, 3)(
+		c, c, c/2,
+		0, c, c,
+		0, 0, c,
+	)
+
+	if !equalApproximately(e, expected, 1e-13*c) {
+		t.Fatal("The exponential of Jordan block should be computed.")
+	}
+}
+
+func TestExpFollowsTolerance(t *testing.T) {
+	if padeDegree(1e-4) >= padeDegree(arrays.Epsilon) {
+		t.Fatal("A looser tolerance should use a lower degree of approximant.")
+	}
+
+	m := dense.New(1, 1)(1)
+
+	e, err := Exp(m, &Settings{Tolerance: 1e-4})
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if math.Abs(e.Get(0, 0)-math.E) > 1e-4*math.E {
+		t.Fatal("The exponential should be accurate within the tolerance.")
+	}
+}
+
+func TestExpCausesPanicForNonSquare(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_SQUARE_PANIC {
+			t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+		}
+	}()
+
+	Exp(dense.Zeros(2, 3), nil)
+}
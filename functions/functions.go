@@ -0,0 +1,146 @@
+/*
+Package "functions" provides functions of square matrices,
+such as the exponential, the logarithm, the square root and powers.
+
+Functions accept any matrix satisfying "types.Matrix" and return results as dense matrices.
+An invalid shape causes a panic defined in "validates",
+while a spectrum for which the function is not defined is reported as an error value.
+*/
+package functions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/decompositions"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+const (
+	SingularError            = "SingularError"
+	UnsupportedSpectrumError = "UnsupportedSpectrumError"
+	NotConvergedError        = "NotConvergedError"
+	NonFiniteError           = "NonFiniteError"
+)
+
+const defaultIterations = 100
+
+/*
+"Settings" controls the accuracy of matrix functions.
+The zero value is available,
+where the tolerance is the machine epsilon and the maximum number of iterations is 100.
+*/
+type Settings struct {
+	// The relative accuracy required for the rational approximations and the iterations.
+	Tolerance float64
+
+	// The maximum number of iterations for the square root.
+	MaxIterations int
+}
+
+// Return the tolerance and the maximum number of iterations.
+func (s *Settings) values() (tolerance float64, iterations int) {
+	if s == nil {
+		s = &Settings{}
+	}
+
+	tolerance = s.Tolerance
+	if tolerance <= 0 {
+		tolerance = arrays.Epsilon
+	}
+
+	iterations = s.MaxIterations
+	if iterations <= 0 {
+		iterations = defaultIterations
+	}
+
+	return tolerance, iterations
+}
+
+// Check that no eigenvalue of "m" lies on the closed negative real axis,
+// where the principal logarithm and fractional powers are not defined.
+func validateSpectrum(m types.Matrix, a *arrays.Array) error {
+	e, err := decompositions.NewEigen(m, false)
+	if err != nil {
+		return err
+	}
+
+	threshold := float64(a.Rows()) * arrays.Epsilon * norm1(a)
+
+	for _, value := range e.Values() {
+		if imag(value) == 0 && real(value) <= threshold {
+			return errors.New(UnsupportedSpectrumError)
+		}
+	}
+
+	return nil
+}
+
+// Return the maximum absolute column sum of "a".
+func norm1(a *arrays.Array) float64 {
+	rows, columns := a.Shape()
+	n := 0.0
+
+	for j := 0; j < columns; j++ {
+		s := 0.0
+		for i := 0; i < rows; i++ {
+			s += math.Abs(a.Get(i, j))
+		}
+		n = math.Max(n, s)
+	}
+
+	return n
+}
+
+// Return "alpha * a + beta * b" as a new array.
+func combine(alpha float64, a *arrays.Array, beta float64, b *arrays.Array) *arrays.Array {
+	c := arrays.Zeros(a.Shape())
+
+	ce, ae, be := c.Elements(), a.Elements(), b.Elements()
+	for i := range ce {
+		ce[i] = alpha*ae[i] + beta*be[i]
+	}
+
+	return c
+}
+
+// Return "a + s * I" as a new array.
+func shift(a *arrays.Array, s float64) *arrays.Array {
+	b := a.Copy()
+
+	for i := 0; i < b.Rows(); i++ {
+		b.Update(i, i, b.Get(i, i)+s)
+	}
+
+	return b
+}
+
+// Solve "a * x = b" with the LU decomposition with partial pivoting,
+// and return "x" with the logarithm of "|det(a)|".
+// When "a" is singular, an error will be returned.
+func solve(a, b *arrays.Array) (x *arrays.Array, logDet float64, err error) {
+	d := arrays.NewLU(a, 0)
+	if d.Singular() {
+		return nil, 0, errors.New(SingularError)
+	}
+
+	return d.Solve(b), d.LogDeterminant(), nil
+}
+
+// Return the inverse of "a" with the logarithm of "|det(a)|".
+// When "a" is singular, an error will be returned.
+func inverse(a *arrays.Array) (*arrays.Array, float64, error) {
+	return solve(a, arrays.Identity(a.Rows()))
+}
+
+// Return an error when "a" has a NaN or infinite element.
+func validateFinite(a *arrays.Array) error {
+	for _, element := range a.Elements() {
+		if math.IsNaN(element) || math.IsInf(element, 0) {
+			return errors.New(NonFiniteError)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Check whether two matrices are equal within the given absolute tolerance.
+func equalApproximately(m, n types.Matrix, tolerance float64) bool {
+	if m.Rows() != n.Rows() || m.Columns() != n.Columns() {
+		return false
+	}
+
+	cursor := m.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if math.Abs(element-n.Get(row, column)) > tolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSolveComputesInverseAndDeterminant(t *testing.T) {
+	a := arrays.Convert(dense.New(3, 3)(
+		0, 2, 1,
+		1, 1, 0,
+		3, 0, 2,
+	))
+
+	x, logDet, err := inverse(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(a.Multiply(x).Matrix(), arrays.Identity(3).Matrix(), 1e-12) {
+		t.Fatal("The product of an array and its inverse should be the identity.")
+	}
+
+	if math.Abs(logDet-math.Log(7)) > 1e-12 {
+		t.Fatalf("The logarithm of |det(a)| should be log(7), but is %v.", logDet)
+	}
+}
+
+func TestSolveFailsForSingularArray(t *testing.T) {
+	a := arrays.Convert(dense.New(2, 2)(
+		1, 2,
+		2, 4,
+	))
+
+	if _, _, err := inverse(a); err == nil || err.Error() != SingularError {
+		t.Fatalf("A singular array should cause %s.", SingularError)
+	}
+}
+
+func TestGaussLegendreIntegratesPolynomialsExactly(t *testing.T) {
+	nodes, weights := gaussLegendre(4)
+
+	// The 4-point quadrature is exact for polynomials of degree 7.
+	s := 0.0
+	for j := range nodes {
+		s += weights[j] * math.Pow(nodes[j], 7)
+	}
+
+	if math.Abs(s-1.0/8) > 1e-14 {
+		t.Fatalf("The integral of x^7 on [0, 1] should be 1/8, but is %v.", s)
+	}
+}
+
+func TestFunctionsReturnErrorForNonFiniteMatrix(t *testing.T) {
+	functions := map[string]func(m types.Matrix) (*dense.Matrix, error){
+		"Exp":  func(m types.Matrix) (*dense.Matrix, error) { return Exp(m, nil) },
+		"Log":  func(m types.Matrix) (*dense.Matrix, error) { return Log(m, nil) },
+		"Sqrt": func(m types.Matrix) (*dense.Matrix, error) { return Sqrt(m, nil) },
+		"Pow":  func(m types.Matrix) (*dense.Matrix, error) { return Pow(m, 0.5, nil) },
+		"Pow2": func(m types.Matrix) (*dense.Matrix, error) { return Pow(m, 2, nil) },
+	}
+
+	for name, f := range functions {
+		for _, element := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			m := dense.New(2, 2)(
+				1, element,
+				0, 1,
+			)
+
+			if _, err := f(m); err == nil || err.Error() != NonFiniteError {
+				t.Fatalf("%s of a matrix with %v should cause %s.", name, element, NonFiniteError)
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package functions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	// The square roots are taken until "|a - I|" becomes lower than this.
+	logThreshold = 0.25
+
+	maxSquareRoots = 64
+	maxLogDegree   = 16
+)
+
+// Compute the principal logarithm of the square matrix "m" with the inverse scaling and squaring method.
+// The square roots of "m" are taken until it is close to the identity,
+// and then "log(I + x)" is approximated with the diagonal Padé approximant
+// evaluated as the Gauss-Legendre quadrature of "x * (I + t * x)^-1".
+// The degree of approximant is the smallest one whose error is lower than the tolerance.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When "m" has an eigenvalue on the closed negative real axis, an error will be returned.
+// When "m" has a NaN or infinite element, an error will be returned.
+func Log(m types.Matrix, s *Settings) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	a := arrays.Convert(m)
+	if err := validateFinite(a); err != nil {
+		return nil, err
+	}
+
+	if err := validateSpectrum(m, a); err != nil {
+		return nil, err
+	}
+
+	tolerance, iterations := s.values()
+
+	l, err := log(a, tolerance, iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.Matrix(), nil
+}
+
+// Compute the principal logarithm of "a" whose spectrum is already validated.
+func log(a *arrays.Array, tolerance float64, iterations int) (*arrays.Array, error) {
+	n := a.Rows()
+
+	roots := 0
+	for ; norm1(shift(a, -1)) > logThreshold; roots++ {
+		if roots == maxSquareRoots {
+			return nil, errors.New(NotConvergedError)
+		}
+
+		root, err := sqrt(a, tolerance, iterations)
+		if err != nil {
+			return nil, err
+		}
+		a = root
+	}
+
+	x := shift(a, -1)
+	nodes, weights := gaussLegendre(logDegree(norm1(x), tolerance))
+
+	l := arrays.Zeros(n, n)
+	for j := range nodes {
+		// "x * (I + t * x)^-1" equals to "(I + t * x)^-1 * x" since they commute.
+		y, _, err := solve(shift(combine(nodes[j], x, 0, x), 1), x)
+		if err != nil {
+			return nil, err
+		}
+
+		l = combine(1, l, weights[j], y)
+	}
+
+	return combine(math.Ldexp(1, roots), l, 0, l), nil
+}
+
+// Return the smallest degree whose approximant of "log(1 + x)" has the error lower than "tolerance"
+// at "x = -norm", which bounds the error for a matrix with the norm "norm".
+func logDegree(norm, tolerance float64) int {
+	if norm == 0 {
+		return 1
+	}
+
+	exact := math.Log1p(-norm)
+
+	for m := 1; m < maxLogDegree; m++ {
+		nodes, weights := gaussLegendre(m)
+
+		r := 0.0
+		for j := range nodes {
+			r += weights[j] * -norm / (1 - nodes[j]*norm)
+		}
+
+		if math.Abs(r-exact) <= tolerance*math.Abs(exact) {
+			return m
+		}
+	}
+
+	return maxLogDegree
+}
+
+// Return the nodes and weights of the "m"-point Gauss-Legendre quadrature on [0, 1].
+// The nodes are the roots of the Legendre polynomial found with Newton's method.
+func gaussLegendre(m int) (nodes, weights []float64) {
+	nodes = make([]float64, m)
+	weights = make([]float64, m)
+
+	for i := 0; i < m; i++ {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(m) + 0.5))
+
+		var derivative float64
+		for iteration := 0; iteration < 100; iteration++ {
+			// Evaluate the Legendre polynomial of degree "m" and its derivative by the recurrence.
+			p0, p1 := 1.0, x
+			if m == 0 {
+				p1 = 1
+			}
+			for k := 2; k <= m; k++ {
+				p0, p1 = p1, (float64(2*k-1)*x*p1-float64(k-1)*p0)/float64(k)
+			}
+			derivative = float64(m) * (x*p1 - p0) / (x*x - 1)
+
+			dx := p1 / derivative
+			x -= dx
+
+			if math.Abs(dx) <= arrays.Epsilon {
+				break
+			}
+		}
+
+		nodes[i] = (1 - x) / 2
+		weights[i] = 1 / ((1 - x*x) * derivative * derivative)
+	}
+
+	return nodes, weights
+}
@@ -0,0 +1,63 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestLogIsInverseOfExp(t *testing.T) {
+	m := dense.New(3, 3)(
+		0.5, 1, -2,
+		-1, 0.2, 0.5,
+		0.3, -0.4, 1,
+	)
+
+	e, err := Exp(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	l, err := Log(e, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(l, m, 1e-12) {
+		t.Fatal("The logarithm of exp(m) should equal to m when the eigenvalues of m are small.")
+	}
+}
+
+func TestLogComputesRotationAngle(t *testing.T) {
+	theta := 3.0
+	m := dense.New(2, 2)(
+		math.Cos(theta), -math.Sin(theta),
+		math.Sin(theta), math.Cos(theta),
+	)
+
+	l, err := Log(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := dense.New(2, 2)(
+		0, -theta,
+		theta, 0,
+	)
+
+	if !equalApproximately(l, expected, 1e-12) {
+		t.Fatal("The logarithm of rotation should be the skew-symmetric matrix of its angle.")
+	}
+}
+
+func TestLogFailsForNegativeEigenvalue(t *testing.T) {
+	m := dense.New(2, 2)(
+		2, 1,
+		0, -3,
+	)
+
+	if _, err := Log(m, nil); err == nil || err.Error() != UnsupportedSpectrumError {
+		t.Fatalf("A negative eigenvalue should cause %s.", UnsupportedSpectrumError)
+	}
+}
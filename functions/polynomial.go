@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Polynomial" is the polynomial "p(x) = c[0] + c[1] * x + ... + c[d] * x^d" with the coefficients "c".
+*/
+type Polynomial []float64
+
+// Evaluate the polynomial at the square matrix "m" with the Paterson-Stockmeyer method,
+// which needs about "2 * sqrt(d)" matrix multiplications for the degree "d".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func (p Polynomial) Evaluate(m types.Matrix) *dense.Matrix {
+	validates.ShapeShouldBeSquare(m)
+
+	n := m.Rows()
+
+	if len(p) == 0 {
+		return dense.Zeros(n, n)
+	}
+
+	degree := len(p) - 1
+
+	// Compute the powers "I, m, ..., m^s".
+	s := int(math.Sqrt(float64(degree + 1)))
+	powers := make([]*arrays.Array, s+1)
+	powers[0] = arrays.Identity(n)
+	if s > 0 {
+		powers[1] = arrays.Convert(m)
+	}
+	for j := 2; j <= s; j++ {
+		powers[j] = arrays.Zeros(n, n)
+		multiplyInto(powers[j], powers[j-1], powers[1])
+	}
+
+	// Apply Horner's method in "m^s" to the blocks "b_k = sum_j c[s * k + j] * m^j".
+	r := degree / s
+	result := p.block(powers, s, r)
+	buffer := arrays.Zeros(n, n)
+
+	for k := r - 1; k >= 0; k-- {
+		multiplyInto(buffer, result, powers[s])
+		result, buffer = buffer, result
+
+		b := p.block(powers, s, k)
+		re, be := result.Elements(), b.Elements()
+		for i := range re {
+			re[i] += be[i]
+		}
+	}
+
+	return result.Matrix()
+}
+
+// Return "sum_j c[s * k + j] * m^j" for "j" in [0, s).
+func (p Polynomial) block(powers []*arrays.Array, s, k int) *arrays.Array {
+	n := powers[0].Rows()
+	b := arrays.Zeros(n, n)
+	be := b.Elements()
+
+	for j := 0; j < s && s*k+j < len(p); j++ {
+		c := p[s*k+j]
+		if c == 0 {
+			continue
+		}
+
+		for i, e := range powers[j].Elements() {
+			be[i] += c * e
+		}
+	}
+
+	return b
+}
@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestPolynomialEvaluateMatchesHorner(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 0,
+		-1, 0, 1,
+		2, 1, -1,
+	)
+
+	for degree := 0; degree < 12; degree++ {
+		p := make(Polynomial, degree+1)
+		for i := range p {
+			p[i] = float64(i%4) - 1.5
+		}
+
+		// Evaluate with Horner's method as a reference.
+		expected := dense.Zeros(3, 3)
+		for i := degree; i >= 0; i-- {
+			expected = expected.Multiply(m).(*dense.Matrix)
+			for j := 0; j < 3; j++ {
+				expected.Update(j, j, expected.Get(j, j)+p[i])
+			}
+		}
+
+		if !equalApproximately(p.Evaluate(m), expected, 1e-9) {
+			t.Fatalf("The polynomial of degree %d should be evaluated.", degree)
+		}
+	}
+}
+
+func TestPolynomialEvaluateWithoutCoefficients(t *testing.T) {
+	if !Polynomial(nil).Evaluate(dense.New(2, 2)(1, 2, 3, 4)).Equal(dense.Zeros(2, 2)) {
+		t.Fatal("The polynomial without coefficients should be zero.")
+	}
+}
+
+func TestPolynomialEvaluateCausesPanicForNonSquare(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_SQUARE_PANIC {
+			t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+		}
+	}()
+
+	Polynomial{1, 2}.Evaluate(dense.Zeros(2, 3))
+}
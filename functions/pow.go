@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Compute the "p"-th power of the square matrix "m".
+// When "p" is an integer, the power is computed by repeated squaring,
+// and a negative power is that of the inverse.
+// Otherwise, the principal power is computed as "exp(p * log(m))".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When "p" is negative and "m" is singular, an error will be returned.
+// When "p" is not an integer and "m" has an eigenvalue on the closed negative real axis,
+// an error will be returned.
+// When "m" has a NaN or infinite element, an error will be returned.
+func Pow(m types.Matrix, p float64, s *Settings) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	a := arrays.Convert(m)
+	if err := validateFinite(a); err != nil {
+		return nil, err
+	}
+
+	if p == math.Trunc(p) && math.Abs(p) < 1<<62 {
+		if p < 0 {
+			inverse, _, err := inverse(a)
+			if err != nil {
+				return nil, err
+			}
+
+			a, p = inverse, -p
+		}
+
+		return power(a, uint64(p)).Matrix(), nil
+	}
+
+	if err := validateSpectrum(m, a); err != nil {
+		return nil, err
+	}
+
+	tolerance, iterations := s.values()
+
+	l, err := log(a, tolerance, iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := exp(combine(p, l, 0, l), tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Matrix(), nil
+}
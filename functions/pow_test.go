@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestPowComputesIntegerPowers(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 1,
+		1, 0,
+	)
+
+	p, err := Pow(m, 10, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	// The powers of this matrix consist of the Fibonacci numbers.
+	if !p.Equal(dense.New(2, 2)(89, 55, 55, 34)) {
+		t.Fatal("The 10th power should be computed by repeated squaring.")
+	}
+
+	p, err = Pow(m, -2, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(p.Multiply(m).Multiply(m), dense.New(2, 2)(1, 0, 0, 1), 1e-13) {
+		t.Fatal("The negative power should be the power of inverse.")
+	}
+}
+
+func TestPowComputesFractionalPowers(t *testing.T) {
+	m := dense.New(3, 3)(
+		4, 1, 0,
+		1, 5, 2,
+		0, 2, 6,
+	)
+
+	p, err := Pow(m, 1.0/3, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(p.Multiply(p).Multiply(p), m, 1e-12) {
+		t.Fatal("The cube of the 1/3 power should equal to the original matrix.")
+	}
+
+	s, err := Sqrt(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	h, err := Pow(m, 0.5, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(h, s, 1e-12) {
+		t.Fatal("The 1/2 power should equal to the square root.")
+	}
+}
+
+func TestPowFailsForSingularOrUnsupportedMatrix(t *testing.T) {
+	singular := dense.New(2, 2)(
+		1, 2,
+		2, 4,
+	)
+
+	if _, err := Pow(singular, -1, nil); err == nil || err.Error() != SingularError {
+		t.Fatalf("A negative power of singular matrix should cause %s.", SingularError)
+	}
+
+	if _, err := Pow(singular, 0.5, nil); err == nil || err.Error() != UnsupportedSpectrumError {
+		t.Fatalf("A fractional power of singular matrix should cause %s.", UnsupportedSpectrumError)
+	}
+}
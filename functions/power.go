@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Compute the "n"-th power of the square matrix "m" by binary exponentiation.
+// The intermediate products are written into three buffers allocated once,
+// so the number of allocations doesn't depend on "n".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "n" is negative, validates.OUT_OF_RANGE_PANIC will be caused.
+func Power(m types.Matrix, n int) *dense.Matrix {
+	validates.ShapeShouldBeSquare(m)
+
+	if n < 0 {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	return power(arrays.Convert(m), uint64(n)).Matrix()
+}
+
+// Compute "a^n" by repeated squaring. "a" is overwritten.
+func power(a *arrays.Array, n uint64) *arrays.Array {
+	size := a.Rows()
+
+	result := arrays.Identity(size)
+	buffer := arrays.Zeros(size, size)
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			multiplyInto(buffer, result, a)
+			result, buffer = buffer, result
+		}
+
+		if n > 1 {
+			multiplyInto(buffer, a, a)
+			a, buffer = buffer, a
+		}
+	}
+
+	return result
+}
+
+// Compute "a * b" into "c", which should be distinct from "a" and "b".
+func multiplyInto(c, a, b *arrays.Array) {
+	n := a.Columns()
+	columns := b.Columns()
+
+	ce, ae, be := c.Elements(), a.Elements(), b.Elements()
+
+	for i := range ce {
+		ce[i] = 0
+	}
+
+	for i := 0; i < a.Rows(); i++ {
+		row := ce[i*columns : (i+1)*columns]
+
+		for k := 0; k < n; k++ {
+			element := ae[i*n+k]
+			for j, e := range be[k*columns : (k+1)*columns] {
+				row[j] += element * e
+			}
+		}
+	}
+}
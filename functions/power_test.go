@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestPowerCountsPaths(t *testing.T) {
+	// The adjacency matrix of the path graph with three vertices.
+	m := dense.New(3, 3)(
+		0, 1, 0,
+		1, 0, 1,
+		0, 1, 0,
+	)
+
+	expected := []*dense.Matrix{
+		dense.New(3, 3)(1, 0, 0, 0, 1, 0, 0, 0, 1),
+		m,
+		dense.New(3, 3)(1, 0, 1, 0, 2, 0, 1, 0, 1),
+		dense.New(3, 3)(0, 2, 0, 2, 0, 2, 0, 2, 0),
+		dense.New(3, 3)(0, 8, 0, 8, 0, 8, 0, 8, 0),
+	}
+
+	for i, n := range []int{0, 1, 2, 3, 7} {
+		if !Power(m, n).Equal(expected[i]) {
+			t.Fatalf("The %d-th power should be %v.", n, expected[i])
+		}
+	}
+}
+
+func TestPowerReusesIntermediates(t *testing.T) {
+	a := arrays.Convert(dense.New(2, 2)(
+		1, 1,
+		1, 0,
+	))
+
+	small := testing.AllocsPerRun(10, func() { power(a.Copy(), 3) })
+	large := testing.AllocsPerRun(10, func() { power(a.Copy(), 1000) })
+
+	if small != large {
+		t.Fatalf("The number of allocations should not depend on n, but is %v against %v.", large, small)
+	}
+}
+
+func TestPowerPropagatesNaNThroughZero(t *testing.T) {
+	m := dense.New(2, 2)(
+		math.Inf(1), 0,
+		0, 1,
+	)
+
+	// "0 * Inf" is NaN as same as "(Matrix).Multiply".
+	p := Power(m, 2)
+
+	if !math.IsNaN(p.Get(1, 0)) || !math.IsNaN(m.Multiply(m).Get(1, 0)) {
+		t.Fatal("A zero multiplied by infinity should be NaN.")
+	}
+}
+
+func TestPowerCausesPanicForNegativeExponent(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("A negative exponent should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	Power(dense.Zeros(2, 2), -1)
+}
+
+func TestPowerCausesPanicForNonSquare(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_SQUARE_PANIC {
+			t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+		}
+	}()
+
+	Power(dense.Zeros(2, 3), 2)
+}
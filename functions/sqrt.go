@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Below this distance from the identity, the determinantal scaling is turned off.
+const scalingThreshold = 1e-2
+
+// Compute the principal square root of the square matrix "m"
+// with the product form of the Denman-Beavers iteration with determinantal scaling.
+// The iteration stops when "|mk - I|" is lower than the tolerance or stagnates at the rounding level.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When "m" has an eigenvalue on the closed negative real axis, an error will be returned,
+// and so will be when the iteration doesn't converge.
+// When "m" has a NaN or infinite element, an error will be returned.
+func Sqrt(m types.Matrix, s *Settings) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	a := arrays.Convert(m)
+	if err := validateFinite(a); err != nil {
+		return nil, err
+	}
+
+	if err := validateSpectrum(m, a); err != nil {
+		return nil, err
+	}
+
+	tolerance, iterations := s.values()
+
+	y, err := sqrt(a, tolerance, iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	return y.Matrix(), nil
+}
+
+// Compute the principal square root of "a" whose spectrum is already validated.
+// This is the iteration (6.29) of "Functions of Matrices" by Higham.
+func sqrt(a *arrays.Array, tolerance float64, iterations int) (*arrays.Array, error) {
+	n := a.Rows()
+
+	mk := a.Copy()
+	yk := a.Copy()
+
+	previous := math.Inf(1)
+	scaling := true
+
+	for k := 0; k < iterations; k++ {
+		inverse, logDet, err := inverse(mk)
+		if err != nil {
+			return nil, err
+		}
+
+		mu := 1.0
+		if scaling {
+			mu = math.Exp(-logDet / float64(2*n))
+		}
+
+		// "y = mu * y * (I + mu^-2 * m^-1) / 2" and "m = (I + (mu^2 * m + mu^-2 * m^-1) / 2) / 2".
+		yk = combine(mu/2, yk, 1/(2*mu), yk.Multiply(inverse))
+		mk = shift(combine(mu*mu/4, mk, 1/(4*mu*mu), inverse), 0.5)
+
+		distance := norm1(shift(mk, -1))
+
+		if distance <= tolerance {
+			return yk, nil
+		}
+
+		// The quadratic convergence has stopped at the rounding level.
+		if distance <= math.Sqrt(tolerance) && distance >= previous/2 {
+			return yk, nil
+		}
+
+		if distance <= scalingThreshold {
+			scaling = false
+		}
+		previous = distance
+	}
+
+	return nil, errors.New(NotConvergedError)
+}
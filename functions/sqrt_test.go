@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestSqrtComputesPrincipalSquareRoot(t *testing.T) {
+	m := dense.New(3, 3)(
+		4, 1, 0,
+		1, 5, 2,
+		0, 2, 6,
+	)
+
+	s, err := Sqrt(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(s.Multiply(s), m, 1e-13) {
+		t.Fatal("The square of square root should equal to the original matrix.")
+	}
+
+	if !equalApproximately(s, s.Transpose(), 1e-13) {
+		t.Fatal("The principal square root of symmetric matrix should be symmetric.")
+	}
+}
+
+func TestSqrtForComplexEigenvalues(t *testing.T) {
+	// The eigenvalues are "+- 2i", whose principal square roots are "1 +- i".
+	m := dense.New(2, 2)(
+		0, -2,
+		2, 0,
+	)
+
+	s, err := Sqrt(m, nil)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	expected := dense.New(2, 2)(
+		1, -1,
+		1, 1,
+	)
+
+	if !equalApproximately(s, expected, 1e-13) {
+		t.Fatal("The principal square root should have eigenvalues in the right half plane.")
+	}
+}
+
+func TestSqrtFailsForUnsupportedSpectrum(t *testing.T) {
+	for _, m := range []*dense.Matrix{
+		dense.New(2, 2)(-1, 0, 0, 4),
+		dense.New(2, 2)(1, 1, 1, 1),
+	} {
+		if _, err := Sqrt(m, nil); err == nil || err.Error() != UnsupportedSpectrumError {
+			t.Fatalf("A negative or zero eigenvalue should cause %s.", UnsupportedSpectrumError)
+		}
+	}
+}
+
+func TestSqrtFailsWithoutEnoughIterations(t *testing.T) {
+	m := dense.New(2, 2)(
+		100, 1,
+		0, 1,
+	)
+
+	if _, err := Sqrt(m, &Settings{MaxIterations: 1}); err == nil || err.Error() != NotConvergedError {
+		t.Fatalf("A single iteration should cause %s.", NotConvergedError)
+	}
+}
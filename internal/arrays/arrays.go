@@ -0,0 +1,136 @@
+/*
+Package "arrays" provides a row-major array of float64,
+which is used as the working storage of numerical algorithms.
+*/
+package arrays
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// The machine epsilon of float64.
+var Epsilon = math.Pow(2, -52)
+
+type Array struct {
+	rows     int
+	columns  int
+	elements []float64
+}
+
+// Create a new zero array.
+func Zeros(rows, columns int) *Array {
+	a := &Array{
+		rows:     rows,
+		columns:  columns,
+		elements: make([]float64, rows*columns),
+	}
+
+	return a
+}
+
+// Create a new identity array.
+func Identity(size int) *Array {
+	a := Zeros(size, size)
+
+	for i := 0; i < size; i++ {
+		a.elements[i*size+i] = 1
+	}
+
+	return a
+}
+
+// Create a new array with the same elements as the given matrix.
+// Only non-zero elements of the matrix are read.
+func Convert(m types.Matrix) *Array {
+	a := Zeros(m.Shape())
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		a.elements[row*a.columns+column] = element
+	}
+
+	return a
+}
+
+func (a *Array) Shape() (rows, columns int) {
+	return a.rows, a.columns
+}
+
+func (a *Array) Rows() (rows int) {
+	return a.rows
+}
+
+func (a *Array) Columns() (columns int) {
+	return a.columns
+}
+
+// Return the underlying elements in row-major order.
+// The returned slice is shared with the receiver.
+func (a *Array) Elements() []float64 {
+	return a.elements
+}
+
+func (a *Array) Get(row, column int) (element float64) {
+	return a.elements[row*a.columns+column]
+}
+
+func (a *Array) Update(row, column int, element float64) {
+	a.elements[row*a.columns+column] = element
+}
+
+func (a *Array) Copy() *Array {
+	b := Zeros(a.rows, a.columns)
+	copy(b.elements, a.elements)
+
+	return b
+}
+
+func (a *Array) Transpose() *Array {
+	b := Zeros(a.columns, a.rows)
+
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.columns; j++ {
+			b.elements[j*a.rows+i] = a.elements[i*a.columns+j]
+		}
+	}
+
+	return b
+}
+
+func (a *Array) Multiply(b *Array) *Array {
+	r := Zeros(a.rows, b.columns)
+
+	for i := 0; i < a.rows; i++ {
+		row := r.elements[i*r.columns : (i+1)*r.columns]
+
+		for k := 0; k < a.columns; k++ {
+			element := a.elements[i*a.columns+k]
+			for j, e := range b.elements[k*b.columns : (k+1)*b.columns] {
+				row[j] += element * e
+			}
+		}
+	}
+
+	return r
+}
+
+// Return the maximum absolute value of elements.
+func (a *Array) MaxAbs() float64 {
+	m := 0.0
+
+	for _, element := range a.elements {
+		m = math.Max(m, math.Abs(element))
+	}
+
+	return m
+}
+
+// Create a new dense matrix with the same elements as the receiver.
+func (a *Array) Matrix() *dense.Matrix {
+	return dense.New(a.rows, a.columns)(a.elements...)
+}
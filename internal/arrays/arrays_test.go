@@ -0,0 +1,96 @@
+package arrays
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestConvertCopiesElementsOfMatrix(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	).Transpose()
+
+	a := Convert(m)
+
+	if rows, columns := a.Shape(); rows != 3 || columns != 2 {
+		t.Fatalf("The shape should be (3, 2), but is (%d, %d).", rows, columns)
+	}
+
+	if !a.Matrix().Equal(m) {
+		t.Fatal("The converted array should have the same elements as the matrix.")
+	}
+}
+
+func TestIdentityCreatesIdentityArray(t *testing.T) {
+	r := dense.New(3, 3)(
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	)
+
+	if Identity(3).Matrix().Equal(r) {
+		return
+	}
+
+	t.Fatal("Identity should create an identity array.")
+}
+
+func TestTransposeSwapsRowsAndColumns(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	if Convert(m).Transpose().Matrix().Equal(m.Transpose()) {
+		return
+	}
+
+	t.Fatal("The transpose of array should equal to the transpose of matrix.")
+}
+
+func TestMultiplyReturnsTheResultOfMultiplication(t *testing.T) {
+	m := dense.New(3, 2)(
+		0, 1,
+		2, 3,
+		4, 5,
+	)
+
+	n := dense.New(2, 2)(
+		1, -1,
+		2, 0,
+	)
+
+	if Convert(m).Multiply(Convert(n)).Matrix().Equal(m.Multiply(n)) {
+		return
+	}
+
+	t.Fatal("The product of arrays should equal to the product of matrices.")
+}
+
+func TestMultiplyPropagatesNaNThroughZero(t *testing.T) {
+	a := Convert(dense.New(2, 2)(
+		0, 1,
+		1, 0,
+	))
+	b := Convert(dense.New(2, 1)(math.Inf(1), 1))
+
+	if r := a.Multiply(b); !math.IsNaN(r.Get(0, 0)) || !math.IsInf(r.Get(1, 0), 1) {
+		t.Fatal("A zero multiplied by infinity should be NaN.")
+	}
+}
+
+func TestCopyDoesNotShareElements(t *testing.T) {
+	a := Identity(2)
+	b := a.Copy()
+
+	b.Update(0, 1, 5)
+
+	if a.Get(0, 1) == 0 && b.Get(0, 1) == 5 {
+		return
+	}
+
+	t.Fatal("The copy should not share elements with the original.")
+}
@@ -0,0 +1,153 @@
+package arrays
+
+import (
+	"math"
+)
+
+/*
+"LU" is the LU decomposition "p * a = l * u" of a square array with partial pivoting,
+where "l" is unit lower triangular and "u" is upper triangular.
+Both factors are stored in one array, and "p" is kept as the sequence of row interchanges.
+*/
+type LU struct {
+	lu       *Array
+	pivots   []int
+	sign     float64
+	singular bool
+}
+
+// Decompose the square array "a" with Gaussian elimination and partial pivoting.
+// A pivot whose absolute value is not greater than "tolerance" is regarded as zero,
+// and then "a" is regarded as singular.
+func NewLU(a *Array, tolerance float64) *LU {
+	lu := a.Copy()
+	n := lu.rows
+	e := lu.elements
+
+	d := &LU{
+		lu:     lu,
+		pivots: make([]int, n),
+		sign:   1,
+	}
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(e[i*n+k]) > math.Abs(e[p*n+k]) {
+				p = i
+			}
+		}
+		d.pivots[k] = p
+
+		if p != k {
+			swapRows(lu, k, p)
+			d.sign = -d.sign
+		}
+
+		pivot := e[k*n+k]
+		if math.Abs(pivot) <= tolerance {
+			d.singular = true
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			factor := e[i*n+k] / pivot
+			e[i*n+k] = factor
+
+			for j := k + 1; j < n; j++ {
+				e[i*n+j] -= factor * e[k*n+j]
+			}
+		}
+	}
+
+	return d
+}
+
+// Check whether a pivot is regarded as zero.
+func (d *LU) Singular() bool {
+	return d.singular
+}
+
+// Return the determinant, which is zero for a singular array.
+func (d *LU) Determinant() float64 {
+	if d.singular {
+		return 0
+	}
+
+	n := d.lu.rows
+	det := d.sign
+
+	for k := 0; k < n; k++ {
+		det *= d.lu.elements[k*n+k]
+	}
+
+	return det
+}
+
+// Return the logarithm of the absolute value of the determinant, which is -Inf for a singular array.
+func (d *LU) LogDeterminant() float64 {
+	if d.singular {
+		return math.Inf(-1)
+	}
+
+	n := d.lu.rows
+	logDet := 0.0
+
+	for k := 0; k < n; k++ {
+		logDet += math.Log(math.Abs(d.lu.elements[k*n+k]))
+	}
+
+	return logDet
+}
+
+// Solve "a * x = b" for the non-singular array "a",
+// where "b" has the same number of rows as "a".
+func (d *LU) Solve(b *Array) *Array {
+	x := b.Copy()
+	n, columns := d.lu.rows, x.columns
+	e, y := d.lu.elements, x.elements
+
+	for k, p := range d.pivots {
+		if p != k {
+			swapRows(x, k, p)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			factor := e[i*n+k]
+			for j := 0; j < columns; j++ {
+				y[i*columns+j] -= factor * y[k*columns+j]
+			}
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			factor := e[i*n+k]
+			for j := 0; j < columns; j++ {
+				y[i*columns+j] -= factor * y[k*columns+j]
+			}
+		}
+
+		for j := 0; j < columns; j++ {
+			y[i*columns+j] /= e[i*n+i]
+		}
+	}
+
+	return x
+}
+
+// Return the inverse of the non-singular array "a".
+func (d *LU) Inverse() *Array {
+	return d.Solve(Identity(d.lu.rows))
+}
+
+func swapRows(a *Array, i, j int) {
+	columns := a.columns
+	elements := a.elements
+
+	for k := 0; k < columns; k++ {
+		elements[i*columns+k], elements[j*columns+k] = elements[j*columns+k], elements[i*columns+k]
+	}
+}
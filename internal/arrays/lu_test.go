@@ -0,0 +1,62 @@
+package arrays
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestLUSolvesSystemWithPivoting(t *testing.T) {
+	// The leading element is zero, so the rows should be interchanged.
+	a := Convert(dense.New(3, 3)(
+		0, 2, 1,
+		1, 1, 0,
+		2, 0, 3,
+	))
+	b := Convert(dense.New(3, 2)(
+		5, 1,
+		3, 0,
+		11, 2,
+	))
+
+	d := NewLU(a, 0)
+	if d.Singular() {
+		t.Fatal("A non-singular array shouldn't be regarded as singular.")
+	}
+
+	x := d.Solve(b)
+	r := a.Multiply(x)
+
+	for i, element := range r.Elements() {
+		if math.Abs(element-b.Elements()[i]) > 1e-12 {
+			t.Fatal("The solution should satisfy a * x = b.")
+		}
+	}
+
+	if math.Abs(d.Determinant()+8) > 1e-12 || math.Abs(d.LogDeterminant()-math.Log(8)) > 1e-12 {
+		t.Fatalf("The determinant should be -8, but is %v.", d.Determinant())
+	}
+
+	p := a.Multiply(d.Inverse())
+	for i, element := range p.Elements() {
+		if math.Abs(element-Identity(3).Elements()[i]) > 1e-12 {
+			t.Fatal("The product of an array and its inverse should be the identity.")
+		}
+	}
+}
+
+func TestLURegardsNegligiblePivotAsSingular(t *testing.T) {
+	a := Convert(dense.New(2, 2)(
+		1, 2,
+		2, 4+1e-14,
+	))
+
+	if d := NewLU(a, 0); d.Singular() {
+		t.Fatal("A nearly singular array shouldn't be singular without tolerance.")
+	}
+
+	if d := NewLU(a, 1e-12); !d.Singular() || d.Determinant() != 0 || !math.IsInf(d.LogDeterminant(), -1) {
+		t.Fatal("A pivot below the tolerance should be regarded as zero.")
+	}
+}
@@ -60,6 +60,36 @@ type Matrix interface {
 	// Multiply by scalar value.
 	Scalar(s float64) Matrix
 
+	// Replace each element of the receiver matrix with the result of "f".
+	Apply(f func(element float64, row, column int) float64) Matrix
+
+	// Replace each non-zero element of the receiver matrix with the result of "f".
+	// Zero elements are skipped, so "f" should satisfy "f(0, row, column) == 0".
+	ApplyNonZeros(f func(element float64, row, column int) float64) Matrix
+
+	// Create a new matrix whose elements are the results of "f" for the receiver matrix.
+	Map(f func(element float64, row, column int) float64) Matrix
+
+	// Create a new matrix whose elements are the results of "f" for the non-zero elements.
+	// Zero elements are skipped, so "f" should satisfy "f(0, row, column) == 0".
+	MapNonZeros(f func(element float64, row, column int) float64) Matrix
+
+	// Multiply the receiver matrix by the given matrix element-wise.
+	// When the shape of the receiver and the argument is different,
+	// validates.DIFFERENT_SIZE_PANIC will be caused.
+	Hadamard(n Matrix) Matrix
+
+	// Divide the receiver matrix by the given matrix element-wise.
+	// When the shape of the receiver and the argument is different,
+	// validates.DIFFERENT_SIZE_PANIC will be caused.
+	Divide(n Matrix) Matrix
+
+	// Raise each element to the power "p".
+	Pow(p float64) Matrix
+
+	// Replace each element with the absolute value.
+	Abs() Matrix
+
 	// Create the transpose matrix.
 	Transpose() Matrix
 
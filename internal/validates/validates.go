@@ -11,6 +11,9 @@ const (
 	OUT_OF_RANGE_PANIC
 	INVALID_ELEMENTS_PANIC
 	INVALID_VIEW_PANIC
+	NOT_SQUARE_PANIC
+	NOT_VECTOR_PANIC
+	NOT_BROADCASTABLE_PANIC
 )
 
 //go:generate stringer -type=Panic
@@ -49,6 +52,52 @@ func ShapeShouldBeMultipliable(m, n HasShape) {
 	panic(NOT_MULTIPLIABLE_PANIC)
 }
 
+func ShapeShouldBeSquare(m HasShape) {
+	if m.Rows() == m.Columns() {
+		return
+	}
+
+	panic(NOT_SQUARE_PANIC)
+}
+
+func ShapeShouldBeColumnVector(m HasShape) {
+	if m.Columns() == 1 {
+		return
+	}
+
+	panic(NOT_VECTOR_PANIC)
+}
+
+func ShapeShouldBeVector(m HasShape) {
+	if m.Rows() == 1 || m.Columns() == 1 {
+		return
+	}
+
+	panic(NOT_VECTOR_PANIC)
+}
+
+// Check that "m" and "n" have the same number of elements, such as a row vector and a column vector.
+func ShapeShouldBeSameLength(m, n HasShape) {
+	if m.Rows()*m.Columns() == n.Rows()*n.Columns() {
+		return
+	}
+
+	panic(DIFFERENT_SIZE_PANIC)
+}
+
+// Check that each dimension of "m" and "n" is the same or one of them is 1 as NumPy.
+func ShapeShouldBeBroadcastable(m, n HasShape) {
+	if broadcastable(m.Rows(), n.Rows()) && broadcastable(m.Columns(), n.Columns()) {
+		return
+	}
+
+	panic(NOT_BROADCASTABLE_PANIC)
+}
+
+func broadcastable(x, y int) bool {
+	return x == y || x == 1 || y == 1
+}
+
 func IndexShouldBeInRange(rows, columns, row, column int) {
 	if (0 <= row && row < rows) && (0 <= column && column < columns) {
 		return
@@ -202,6 +202,143 @@ func TestShapeShouldBeMultipliableCausesPanic(t *testing.T) {
 	ShapeShouldBeMultipliable(m, n)
 }
 
+func TestShapeShouldBeSquareCausesNothing(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 3}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("A square matrix should be valid, but causes %s.", p)
+		}
+	}()
+	ShapeShouldBeSquare(m)
+}
+
+func TestShapeShouldBeSquareCausesPanic(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 2}
+
+	defer func() {
+		if p := recover(); p == NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("A non-square matrix should cause %s.", NOT_SQUARE_PANIC)
+	}()
+	ShapeShouldBeSquare(m)
+}
+
+func TestShapeShouldBeColumnVectorCausesNothing(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 1}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("A column vector should be valid, but causes %s.", p)
+		}
+	}()
+	ShapeShouldBeColumnVector(m)
+}
+
+func TestShapeShouldBeColumnVectorCausesPanic(t *testing.T) {
+	m := &shapeTest{rows: 1, columns: 3}
+
+	defer func() {
+		if p := recover(); p == NOT_VECTOR_PANIC {
+			return
+		}
+
+		t.Fatalf("A row vector should cause %s.", NOT_VECTOR_PANIC)
+	}()
+	ShapeShouldBeColumnVector(m)
+}
+
+func TestShapeShouldBeVectorCausesNothing(t *testing.T) {
+	tests := []*shapeTest{
+		{rows: 3, columns: 1},
+		{rows: 1, columns: 3},
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("A vector should be valid, but causes %s.", p)
+		}
+	}()
+
+	for _, test := range tests {
+		ShapeShouldBeVector(test)
+	}
+}
+
+func TestShapeShouldBeVectorCausesPanic(t *testing.T) {
+	m := &shapeTest{rows: 2, columns: 3}
+
+	defer func() {
+		if p := recover(); p == NOT_VECTOR_PANIC {
+			return
+		}
+
+		t.Fatalf("A matrix should cause %s.", NOT_VECTOR_PANIC)
+	}()
+	ShapeShouldBeVector(m)
+}
+
+func TestShapeShouldBeSameLengthCausesNothing(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 1}
+	n := &shapeTest{rows: 1, columns: 3}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("Vectors of the same length should be valid, but cause %s.", p)
+		}
+	}()
+	ShapeShouldBeSameLength(m, n)
+}
+
+func TestShapeShouldBeSameLengthCausesPanic(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 1}
+	n := &shapeTest{rows: 1, columns: 2}
+
+	defer func() {
+		if p := recover(); p == DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf("Vectors of different lengths should cause %s.", DIFFERENT_SIZE_PANIC)
+	}()
+	ShapeShouldBeSameLength(m, n)
+}
+
+func TestShapeShouldBeBroadcastableCausesNothing(t *testing.T) {
+	tests := [][]*shapeTest{
+		{{rows: 3, columns: 2}, {rows: 3, columns: 2}},
+		{{rows: 3, columns: 2}, {rows: 1, columns: 2}},
+		{{rows: 3, columns: 2}, {rows: 3, columns: 1}},
+		{{rows: 3, columns: 1}, {rows: 1, columns: 2}},
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("Two matrices are broadcastable, but cause %s.", p)
+		}
+	}()
+
+	for _, test := range tests {
+		ShapeShouldBeBroadcastable(test[0], test[1])
+	}
+}
+
+func TestShapeShouldBeBroadcastableCausesPanic(t *testing.T) {
+	m := &shapeTest{rows: 3, columns: 2}
+	n := &shapeTest{rows: 2, columns: 1}
+
+	defer func() {
+		if p := recover(); p == NOT_BROADCASTABLE_PANIC {
+			return
+		}
+
+		t.Fatalf("Two matrices should not be broadcastable.")
+	}()
+	ShapeShouldBeBroadcastable(m, n)
+}
+
 func TestIndexShouldBeInRangeCausesNothing(t *testing.T) {
 	testSeq := []*rangeTest{
 		&rangeTest{
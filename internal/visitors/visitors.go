@@ -0,0 +1,13 @@
+/*
+Package "visitors" provides hooks to visit elements of matrix without cursors.
+*/
+package visitors
+
+import (
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Call "f" for each element of "m" in the order of the underlying storage,
+// and return false without calling "f" when "m" is not a dense matrix.
+// This is set by the package "dense" to keep the fast path out of the public API.
+var Dense func(m types.Matrix, f func(element float64, row, column int)) bool
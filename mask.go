@@ -0,0 +1,207 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// The number of bits in a word of "Mask".
+const wordSize = 64
+
+/*
+"Mask" is a boolean matrix stored compactly as bits,
+which is created with element-wise comparisons and used to select or update elements.
+*/
+type Mask struct {
+	rows    int
+	columns int
+	words   []uint64
+}
+
+// Create a new mask whose elements are all false.
+// When "rows" and "columns" is not positive,
+// validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func NewMask(rows, columns int) *Mask {
+	validates.ShapeShouldBePositive(rows, columns)
+
+	m := &Mask{
+		rows:    rows,
+		columns: columns,
+		words:   make([]uint64, (rows*columns+wordSize-1)/wordSize),
+	}
+
+	return m
+}
+
+// Create the mask which is true where the element of "m" is greater than "value".
+func GreaterThan(m Matrix, value float64) *Mask {
+	return compare(m, func(element float64) bool {
+		return element > value
+	})
+}
+
+// Create the mask which is true where the element of "m" is less than "value".
+func LessThan(m Matrix, value float64) *Mask {
+	return compare(m, func(element float64) bool {
+		return element < value
+	})
+}
+
+// Create the mask which is true where the element of "m" differs from "value" by "tolerance" at most.
+func EqualTo(m Matrix, value, tolerance float64) *Mask {
+	return compare(m, func(element float64) bool {
+		return math.Abs(element-value) <= tolerance
+	})
+}
+
+// Create the matrix whose elements are taken from "a" where "mask" is true and from "b" elsewhere.
+// When the shapes of "mask", "a" and "b" are different,
+// validates.DIFFERENT_SIZE_PANIC will be caused.
+func Where(mask *Mask, a, b Matrix) *dense.Matrix {
+	validates.ShapeShouldBeSame(mask, a)
+	validates.ShapeShouldBeSame(mask, b)
+
+	r := dense.Zeros(mask.Shape())
+
+	visit(b, true, func(element float64, row, column int) {
+		if !mask.Get(row, column) {
+			r.Update(row, column, element)
+		}
+	})
+
+	visit(a, true, func(element float64, row, column int) {
+		if mask.Get(row, column) {
+			r.Update(row, column, element)
+		}
+	})
+
+	return r
+}
+
+// Update the elements of "m" with "value" where "mask" is true, and return "m".
+// When the shapes of "m" and "mask" are different,
+// validates.DIFFERENT_SIZE_PANIC will be caused.
+func UpdateWhere(m Matrix, mask *Mask, value float64) Matrix {
+	validates.ShapeShouldBeSame(m, mask)
+
+	cursor := mask.NonZeros()
+
+	for cursor.HasNext() {
+		_, row, column := cursor.Get()
+		m.Update(row, column, value)
+	}
+
+	return m
+}
+
+// Return the shape of mask, which consists of the "rows" and the "columns".
+func (m *Mask) Shape() (rows, columns int) {
+	return m.rows, m.columns
+}
+
+// Return the number of "rows".
+func (m *Mask) Rows() (rows int) {
+	return m.rows
+}
+
+// Return the number of "columns".
+func (m *Mask) Columns() (columns int) {
+	return m.columns
+}
+
+// Get an element of mask specified with "row" and "column".
+// When "row" or "column" is out of range,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (m *Mask) Get(row, column int) bool {
+	validates.IndexShouldBeInRange(m.rows, m.columns, row, column)
+
+	index := row*m.columns + column
+
+	return m.words[index/wordSize]&(1<<uint(index%wordSize)) != 0
+}
+
+// Update the element of mask specified with "row" and "column".
+// When "row" or "column" is out of range,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (m *Mask) Update(row, column int, element bool) *Mask {
+	validates.IndexShouldBeInRange(m.rows, m.columns, row, column)
+
+	index := row*m.columns + column
+	bit := uint64(1) << uint(index%wordSize)
+
+	if element {
+		m.words[index/wordSize] |= bit
+	} else {
+		m.words[index/wordSize] &^= bit
+	}
+
+	return m
+}
+
+// Return the number of true elements.
+func (m *Mask) Count() int {
+	count := 0
+
+	for _, word := range m.words {
+		for ; word != 0; word &= word - 1 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Create and return an iterator for true elements in row-major order,
+// whose element is 1.
+func (m *Mask) NonZeros() Cursor {
+	c := &maskCursor{
+		mask:  m,
+		index: -1,
+	}
+
+	return c
+}
+
+// Create the mask by applying "f" to each element of "m".
+func compare(m Matrix, f func(element float64) bool) *Mask {
+	mask := NewMask(m.Shape())
+
+	visit(m, false, func(element float64, row, column int) {
+		if f(element) {
+			mask.Update(row, column, true)
+		}
+	})
+
+	return mask
+}
+
+type maskCursor struct {
+	mask  *Mask
+	index int
+}
+
+func (c *maskCursor) HasNext() bool {
+	size := c.mask.rows * c.mask.columns
+
+	for c.index++; c.index < size; c.index++ {
+		word := c.mask.words[c.index/wordSize] >> uint(c.index%wordSize)
+
+		// Skip the rest of the word without true elements.
+		if word == 0 {
+			c.index += wordSize - 1 - c.index%wordSize
+			continue
+		}
+
+		if word&1 != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *maskCursor) Get() (element float64, row, column int) {
+	return 1, c.index / c.mask.columns, c.index % c.mask.columns
+}
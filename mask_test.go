@@ -0,0 +1,114 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestComparisonsCreateMasks(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, -2, 3,
+		0.5, math.NaN(), 0,
+	).Transpose()
+
+	greater := GreaterThan(m, 0.5)
+	if greater.Count() != 2 || !greater.Get(0, 0) || !greater.Get(2, 0) || greater.Get(0, 1) {
+		t.Fatal("GreaterThan should be true where the element is greater than the value.")
+	}
+
+	less := LessThan(cursorOnly{m.(*dense.Matrix)}, 0.5)
+	if less.Count() != 2 || !less.Get(1, 0) || !less.Get(2, 1) || less.Get(1, 1) {
+		t.Fatal("LessThan should be true where the element is less than the value.")
+	}
+
+	equal := EqualTo(m, 0.5, 1e-12)
+	if equal.Count() != 1 || !equal.Get(0, 1) {
+		t.Fatal("EqualTo should be true where the element is close to the value.")
+	}
+}
+
+func TestMaskNonZerosIteratesTrueElements(t *testing.T) {
+	mask := NewMask(3, 50)
+	positions := [][]int{{0, 3}, {1, 13}, {1, 14}, {2, 49}}
+
+	for _, p := range positions {
+		mask.Update(p[0], p[1], true)
+	}
+	mask.Update(0, 7, true).Update(0, 7, false)
+
+	cursor := mask.NonZeros()
+
+	for _, p := range positions {
+		if !cursor.HasNext() {
+			t.Fatal("The cursor should iterate all the true elements.")
+		}
+
+		element, row, column := cursor.Get()
+		if element != 1 || row != p[0] || column != p[1] {
+			t.Fatalf("The cursor should return (1, %d, %d), but returns (%v, %d, %d).", p[0], p[1], element, row, column)
+		}
+	}
+
+	if cursor.HasNext() || mask.Count() != len(positions) {
+		t.Fatal("The cursor should iterate only the true elements.")
+	}
+}
+
+func TestWhereSelectsElements(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	b := dense.New(2, 2)(
+		-1, -2,
+		-3, -4,
+	)
+
+	mask := GreaterThan(dense.New(2, 2)(1, 0, 0, 1), 0)
+
+	if !Where(mask, a, b).Equal(dense.New(2, 2)(1, -2, -3, 4)) {
+		t.Fatal("Where should select the elements of a where the mask is true.")
+	}
+}
+
+func TestUpdateWhereRewritesMaskedElements(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, -2, 3,
+		-4, 5, -6,
+		7, -8, 9,
+	)
+
+	v := m.View(1, 1, 2, 2)
+
+	if r := UpdateWhere(v, LessThan(v, 0), 0); r != v {
+		t.Fatal("UpdateWhere should return the receiver.")
+	}
+
+	if !m.Equal(dense.New(3, 3)(1, -2, 3, -4, 5, 0, 7, 0, 9)) {
+		t.Fatal("UpdateWhere should rewrite the elements of the view where the mask is true.")
+	}
+}
+
+func TestWhereCausesPanicForDifferentShapes(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.DIFFERENT_SIZE_PANIC {
+			t.Fatalf("Different shapes should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+		}
+	}()
+
+	Where(NewMask(2, 2), dense.Zeros(2, 2), dense.Zeros(2, 3))
+}
+
+func TestMaskGetCausesPanicForOutOfRange(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("An index out of range should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	NewMask(2, 2).Get(0, 2)
+}
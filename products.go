@@ -0,0 +1,75 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create the outer product "u * v^T" of the vectors "u" and "v",
+// which may be either row or column vectors.
+// When "u" or "v" is not a vector, validates.NOT_VECTOR_PANIC will be caused.
+func Outer(u, v Matrix) *dense.Matrix {
+	validates.ShapeShouldBeVector(u)
+	validates.ShapeShouldBeVector(v)
+
+	x, y := elementsOf(u), elementsOf(v)
+	r := make([]float64, 0, len(x)*len(y))
+
+	for _, a := range x {
+		for _, b := range y {
+			r = append(r, a*b)
+		}
+	}
+
+	return dense.New(len(x), len(y))(r...)
+}
+
+// Return the inner product of the vectors "u" and "v" with compensated summation,
+// which may be either row or column vectors.
+// When "u" or "v" is not a vector, validates.NOT_VECTOR_PANIC will be caused,
+// and when the lengths are different, validates.DIFFERENT_SIZE_PANIC will be caused.
+func Inner(u, v Matrix) float64 {
+	validates.ShapeShouldBeVector(u)
+	validates.ShapeShouldBeVector(v)
+	validates.ShapeShouldBeSameLength(u, v)
+
+	x, y := elementsOf(u), elementsOf(v)
+
+	s := &compensated{}
+	for i := range x {
+		s.add(x[i] * y[i])
+	}
+
+	return s.value()
+}
+
+// Return the inner product of the vectors "u" and "v" as same as "Inner".
+func Dot(u, v Matrix) float64 {
+	return Inner(u, v)
+}
+
+// Create the Kronecker product of "a" and "b",
+// which is the block matrix whose (i, j) block is "a_ij * b".
+func Kron(a, b Matrix) *dense.Matrix {
+	aRows, aColumns := a.Shape()
+	bRows, bColumns := b.Shape()
+
+	x, y := elementsOf(a), elementsOf(b)
+
+	rows, columns := aRows*bRows, aColumns*bColumns
+	r := make([]float64, rows*columns)
+
+	for i := 0; i < aRows; i++ {
+		for j := 0; j < aColumns; j++ {
+			s := x[i*aColumns+j]
+			for k := 0; k < bRows; k++ {
+				row := (i*bRows + k) * columns
+				for l := 0; l < bColumns; l++ {
+					r[row+j*bColumns+l] = s * y[k*bColumns+l]
+				}
+			}
+		}
+	}
+
+	return dense.New(rows, columns)(r...)
+}
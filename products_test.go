@@ -0,0 +1,94 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestOuter(t *testing.T) {
+	u := dense.New(2, 1)(1, 2)
+	v := dense.New(1, 3)(3, 4, 5)
+
+	if !Outer(u, v).Equal(dense.New(2, 3)(3, 4, 5, 6, 8, 10)) {
+		t.Fatal("Outer should create the outer product.")
+	}
+
+	if !Outer(v, u.Transpose()).Equal(dense.New(3, 2)(3, 6, 4, 8, 5, 10)) {
+		t.Fatal("Outer should accept both row and column vectors.")
+	}
+}
+
+func TestInnerAndDot(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if Inner(m.Row(0), m.Row(1)) != 32 || Dot(m.Row(1), m.Row(0).Transpose()) != 32 {
+		t.Fatal("Inner and Dot should return the inner product of vectors.")
+	}
+
+	if Dot(m.Column(0), cursorOnly{m.Column(2).(*dense.Matrix)}) != 27 {
+		t.Fatal("Dot should return the inner product of column views.")
+	}
+}
+
+func TestInnerCausesPanicForDifferentLengths(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.DIFFERENT_SIZE_PANIC {
+			t.Fatalf("Different lengths should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+		}
+	}()
+
+	Inner(dense.Zeros(1, 3), dense.Zeros(2, 1))
+}
+
+func TestOuterCausesPanicForMatrix(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_VECTOR_PANIC {
+			t.Fatalf("A matrix should cause %s.", validates.NOT_VECTOR_PANIC)
+		}
+	}()
+
+	Outer(dense.Zeros(2, 2), dense.Zeros(2, 1))
+}
+
+func TestKron(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		0, -1,
+	)
+
+	b := dense.New(2, 3)(
+		1, 0, 2,
+		0, 1, 0,
+	).Transpose()
+
+	r := dense.New(6, 4)(
+		1, 0, 2, 0,
+		0, 1, 0, 2,
+		2, 0, 4, 0,
+		0, 0, -1, 0,
+		0, 0, 0, -1,
+		0, 0, -2, 0,
+	)
+
+	if !Kron(a, b).Equal(r) {
+		t.Fatal("Kron should create the Kronecker product.")
+	}
+}
+
+func TestKronPropagatesNaNThroughZero(t *testing.T) {
+	k := Kron(dense.New(1, 2)(0, 1), dense.New(1, 2)(math.NaN(), math.Inf(1)))
+
+	if !math.IsNaN(k.Get(0, 0)) || !math.IsNaN(k.Get(0, 1)) {
+		t.Fatal("A zero multiplied by NaN or infinity should be NaN.")
+	}
+
+	if !math.IsNaN(k.Get(0, 2)) || !math.IsInf(k.Get(0, 3), 1) {
+		t.Fatal("Kron should keep NaN and infinite elements of the right operand.")
+	}
+}
@@ -0,0 +1,185 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/visitors"
+)
+
+// Return the sum of all elements of "m" with compensated summation.
+func Sum(m Matrix) float64 {
+	s := &compensated{}
+
+	visit(m, true, func(element float64, row, column int) {
+		s.add(element)
+	})
+
+	return s.value()
+}
+
+// Return the arithmetic mean of all elements of "m".
+func Mean(m Matrix) float64 {
+	return Sum(m) / float64(m.Rows()*m.Columns())
+}
+
+// Return the product of all elements of "m".
+func Product(m Matrix) float64 {
+	p := 1.0
+
+	visit(m, false, func(element float64, row, column int) {
+		p *= element
+	})
+
+	return p
+}
+
+// Return the sum of diagonal elements of "m" with compensated summation.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Trace(m Matrix) float64 {
+	validates.ShapeShouldBeSquare(m)
+
+	s := &compensated{}
+
+	cursor := m.Diagonal()
+
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+		s.add(element)
+	}
+
+	return s.value()
+}
+
+// Return the Frobenius norm "sqrt(sum(m_ij^2))" of "m".
+// The squares are scaled by the largest absolute value to avoid overflow and underflow.
+// The norm is NaN when "m" has a NaN element, and +Inf when "m" has an infinite element otherwise.
+func FrobeniusNorm(m Matrix) float64 {
+	scale, squares, infinite := 0.0, &compensated{}, false
+
+	visit(m, true, func(element float64, row, column int) {
+		if element == 0 {
+			return
+		}
+
+		// Infinite elements aren't scaled, since "Inf / Inf" is NaN.
+		if math.IsInf(element, 0) {
+			infinite = true
+			return
+		}
+
+		a := math.Abs(element)
+
+		if a > scale {
+			r := scale / a
+			squares = &compensated{sum: squares.value() * r * r}
+			squares.add(1)
+			scale = a
+		} else {
+			r := a / scale
+			squares.add(r * r)
+		}
+	})
+
+	n := scale * math.Sqrt(squares.value())
+	if infinite && !math.IsNaN(n) {
+		return math.Inf(1)
+	}
+
+	return n
+}
+
+// Return the 1-norm of "m", which is the maximum absolute column sum.
+func OneNorm(m Matrix) float64 {
+	sums := make([]compensated, m.Columns())
+
+	visit(m, true, func(element float64, row, column int) {
+		sums[column].add(math.Abs(element))
+	})
+
+	return maxOf(sums)
+}
+
+// Return the infinity-norm of "m", which is the maximum absolute row sum.
+func InfinityNorm(m Matrix) float64 {
+	sums := make([]compensated, m.Rows())
+
+	visit(m, true, func(element float64, row, column int) {
+		sums[row].add(math.Abs(element))
+	})
+
+	return maxOf(sums)
+}
+
+// Return the maximum absolute value of elements of "m".
+func MaxAbsNorm(m Matrix) float64 {
+	max := 0.0
+
+	visit(m, true, func(element float64, row, column int) {
+		if a := math.Abs(element); a > max || math.IsNaN(a) {
+			max = a
+		}
+	})
+
+	return max
+}
+
+// Call "f" for each element of "m".
+// A dense matrix is visited without cursors,
+// and the other matrices are visited only for non-zero elements when "nonZeros" is true.
+func visit(m Matrix, nonZeros bool, f func(element float64, row, column int)) {
+	if visitors.Dense(m, f) {
+		return
+	}
+
+	cursor := m.All()
+	if nonZeros {
+		cursor = m.NonZeros()
+	}
+
+	for cursor.HasNext() {
+		f(cursor.Get())
+	}
+}
+
+func maxOf(sums []compensated) float64 {
+	max := 0.0
+
+	for i := range sums {
+		if s := sums[i].value(); s > max || math.IsNaN(s) {
+			max = s
+		}
+	}
+
+	return max
+}
+
+/*
+"compensated" is the sum with Kahan-Babuska compensation by Neumaier,
+which keeps the rounding errors lost in the additions.
+*/
+type compensated struct {
+	sum          float64
+	compensation float64
+}
+
+func (c *compensated) add(x float64) {
+	t := c.sum + x
+
+	if math.Abs(c.sum) >= math.Abs(x) {
+		c.compensation += (c.sum - t) + x
+	} else {
+		c.compensation += (x - t) + c.sum
+	}
+
+	c.sum = t
+}
+
+func (c *compensated) value() float64 {
+	// The compensation is meaningless once the sum overflows.
+	if math.IsInf(c.sum, 0) || math.IsNaN(c.sum) {
+		return c.sum
+	}
+
+	return c.sum + c.compensation
+}
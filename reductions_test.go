@@ -0,0 +1,113 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// "cursorOnly" hides the type of dense matrix to test the reductions through cursors.
+type cursorOnly struct {
+	*dense.Matrix
+}
+
+type reductionTest struct {
+	name   string
+	reduce func(m Matrix) float64
+	result float64
+}
+
+func TestReductions(t *testing.T) {
+	m := dense.New(3, 4)(
+		0, 0, 0, 0,
+		0, 1, -2, 3,
+		0, -4, 5, 0.5,
+	).View(1, 1, 2, 3).Transpose()
+
+	tests := []*reductionTest{
+		{name: "Sum", reduce: Sum, result: 3.5},
+		{name: "Mean", reduce: Mean, result: 3.5 / 6},
+		{name: "Product", reduce: Product, result: 1 * -2 * 3 * -4 * 5 * 0.5},
+		{name: "FrobeniusNorm", reduce: FrobeniusNorm, result: math.Sqrt(55.25)},
+		{name: "OneNorm", reduce: OneNorm, result: 9.5},
+		{name: "InfinityNorm", reduce: InfinityNorm, result: 7},
+		{name: "MaxAbsNorm", reduce: MaxAbsNorm, result: 5},
+	}
+
+	for _, test := range tests {
+		for _, n := range []Matrix{m, cursorOnly{m.(*dense.Matrix)}} {
+			if result := test.reduce(n); math.Abs(result-test.result) > 1e-14 {
+				t.Fatalf("%s should be %v, but is %v.", test.name, test.result, result)
+			}
+		}
+	}
+}
+
+func TestProductIncludesZeros(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		0, 3,
+	)
+
+	if Product(m) != 0 || Product(cursorOnly{m}) != 0 {
+		t.Fatal("The product should be zero for a matrix with a zero element.")
+	}
+}
+
+func TestSumIsCompensated(t *testing.T) {
+	m := dense.New(1, 4)(1e16, 1, -1e16, 1)
+
+	if s := Sum(m); s != 2 {
+		t.Fatalf("The sum should be 2, but is %v.", s)
+	}
+}
+
+func TestSumKeepsInfinity(t *testing.T) {
+	m := dense.New(1, 3)(math.Inf(1), 1, 2)
+
+	if s := Sum(m); !math.IsInf(s, 1) {
+		t.Fatalf("The sum should be infinity, but is %v.", s)
+	}
+}
+
+func TestFrobeniusNormAvoidsOverflow(t *testing.T) {
+	m := dense.New(1, 2)(3e200, 4e200)
+
+	if n := FrobeniusNorm(m); math.Abs(n-5e200) > 1e186 {
+		t.Fatalf("The norm should be 5e200, but is %v.", n)
+	}
+}
+
+func TestFrobeniusNormOfInfiniteElements(t *testing.T) {
+	if n := FrobeniusNorm(dense.New(1, 2)(math.Inf(1), math.Inf(-1))); !math.IsInf(n, 1) {
+		t.Fatalf("The norm should be +Inf, but is %v.", n)
+	}
+
+	if n := FrobeniusNorm(cursorOnly{dense.New(1, 3)(math.Inf(1), 1, math.NaN())}); !math.IsNaN(n) {
+		t.Fatalf("The norm should be NaN, but is %v.", n)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	)
+
+	if Trace(m) != 15 || Trace(m.View(1, 0, 2, 2)) != 12 {
+		t.Fatal("The trace should be the sum of diagonal elements.")
+	}
+}
+
+func TestTraceCausesPanicForNonSquareMatrix(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_SQUARE_PANIC {
+			t.Fatalf("A non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+		}
+	}()
+
+	Trace(dense.Zeros(2, 3))
+}
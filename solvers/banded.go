@@ -0,0 +1,190 @@
+package solvers
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"BandedLU" is the LU factorization with partial pivoting "p * a = l * u"
+of a banded matrix with "lower" sub-diagonals and "upper" super-diagonals.
+The factor "u" has "lower + upper" super-diagonals because of row interchanges.
+*/
+type BandedLU struct {
+	size  int
+	lower int
+	upper int
+
+	// The band of "u" and the multipliers of "l" stored row by row,
+	// where the "k"-th element of row "i" is the element in the column "i - lower + k".
+	band  []float64
+	width int
+
+	pivots []int
+}
+
+// Compute the LU factorization of the banded matrix "a"
+// with "lower" sub-diagonals and "upper" super-diagonals.
+// Only the elements in the band are read with "(Matrix).Get",
+// so the cost is O(n * lower * (lower + upper)).
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when a bandwidth is negative, validates.OUT_OF_RANGE_PANIC will be caused.
+// When "a" is singular, an error will be returned.
+func NewBandedLU(a types.Matrix, lower, upper int) (*BandedLU, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	if lower < 0 || upper < 0 {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	n := a.Rows()
+	width := 2*lower + upper + 1
+
+	d := &BandedLU{
+		size:   n,
+		lower:  lower,
+		upper:  upper,
+		band:   make([]float64, n*width),
+		width:  width,
+		pivots: make([]int, n),
+	}
+
+	for i := 0; i < n; i++ {
+		for j := maxInt(i-lower, 0); j <= minInt(i+upper, n-1); j++ {
+			d.band[d.index(i, j)] = a.Get(i, j)
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		last := minInt(k+lower, n-1)
+
+		// Choose the pivot from the sub-diagonal elements of the column "k".
+		p := k
+		for i := k + 1; i <= last; i++ {
+			if math.Abs(d.band[d.index(i, k)]) > math.Abs(d.band[d.index(p, k)]) {
+				p = i
+			}
+		}
+		d.pivots[k] = p
+
+		pivot := d.band[d.index(p, k)]
+		if pivot == 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+
+		end := minInt(k+lower+upper, n-1)
+
+		if p != k {
+			for j := k; j <= end; j++ {
+				x, y := d.index(k, j), d.index(p, j)
+				d.band[x], d.band[y] = d.band[y], d.band[x]
+			}
+		}
+
+		for i := k + 1; i <= last; i++ {
+			ik := d.index(i, k)
+			d.band[ik] /= pivot
+
+			m := d.band[ik]
+			if m == 0 {
+				continue
+			}
+
+			for j := k + 1; j <= end; j++ {
+				d.band[d.index(i, j)] -= m * d.band[d.index(k, j)]
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// Return the position of the element "(i, j)" in the band storage.
+func (d *BandedLU) index(i, j int) int {
+	return i*d.width + j - i + d.lower
+}
+
+// Solve "a * x = b" for each column of "b".
+// When "a" and "b" are not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func (d *BandedLU) Solve(b types.Matrix) *dense.Matrix {
+	if d.size != b.Rows() {
+		panic(validates.NOT_MULTIPLIABLE_PANIC)
+	}
+
+	n, columns := b.Shape()
+	x := elementsOf(b)
+
+	// Solve "l * y = p * b".
+	for k := 0; k < n; k++ {
+		xk := x[k*columns : (k+1)*columns]
+
+		if p := d.pivots[k]; p != k {
+			xp := x[p*columns : (p+1)*columns]
+			for c := range xk {
+				xk[c], xp[c] = xp[c], xk[c]
+			}
+		}
+
+		for i := k + 1; i <= minInt(k+d.lower, n-1); i++ {
+			m := d.band[d.index(i, k)]
+			xi := x[i*columns : (i+1)*columns]
+			for c := range xi {
+				xi[c] -= m * xk[c]
+			}
+		}
+	}
+
+	// Solve "u * x = y".
+	for i := n - 1; i >= 0; i-- {
+		xi := x[i*columns : (i+1)*columns]
+
+		for j := i + 1; j <= minInt(i+d.lower+d.upper, n-1); j++ {
+			u := d.band[d.index(i, j)]
+			xj := x[j*columns : (j+1)*columns]
+			for c := range xi {
+				xi[c] -= u * xj[c]
+			}
+		}
+
+		u := d.band[d.index(i, i)]
+		for c := range xi {
+			xi[c] /= u
+		}
+	}
+
+	return dense.New(n, columns)(x...)
+}
+
+// Solve "a * x = b" for each column of "b",
+// where "a" is banded with "lower" sub-diagonals and "upper" super-diagonals.
+// This is a shorthand of "NewBandedLU" and "(*BandedLU).Solve".
+func SolveBanded(a, b types.Matrix, lower, upper int) (*dense.Matrix, error) {
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	d, err := NewBandedLU(a, lower, upper)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Solve(b), nil
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+
+	return y
+}
+
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+
+	return y
+}
@@ -0,0 +1,79 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestBandedLUSolvesWithPivoting(t *testing.T) {
+	// The leading element is zero, so the rows should be interchanged.
+	a := dense.New(5, 5)(
+		0, 2, 1, 0, 0,
+		3, 1, 0, 2, 0,
+		1, 4, 1, 1, 1,
+		0, 2, 5, 2, 1,
+		0, 0, 1, 3, 4,
+	)
+	x := dense.New(5, 2)(
+		1, 5,
+		2, 4,
+		3, 3,
+		4, 2,
+		5, 1,
+	)
+
+	d, err := NewBandedLU(a, 2, 2)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	solution := d.Solve(a.Multiply(x))
+
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 2; j++ {
+			if e := solution.Get(i, j) - x.Get(i, j); e > 1e-12 || e < -1e-12 {
+				t.Fatal("The banded system should be solved.")
+			}
+		}
+	}
+}
+
+func TestSolveBandedReadsOnlyBand(t *testing.T) {
+	a := poisson(5)
+	b := a.Multiply(ones(5))
+
+	// The elements out of the band are ignored.
+	a.Update(0, 4, 100)
+	a.Update(4, 0, 100)
+
+	x, err := SolveBanded(a, b, 1, 1)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSolution(t, poisson(5), x, b, 1e-12)
+}
+
+func TestNewBandedLUFailsForSingularMatrix(t *testing.T) {
+	a := dense.New(3, 3)(
+		1, 2, 0,
+		2, 4, 0,
+		0, 1, 1,
+	)
+
+	if _, err := NewBandedLU(a, 1, 1); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A singular matrix should cause %s.", ZeroPivotError)
+	}
+}
+
+func TestNewBandedLUCausesPanicForNegativeBandwidth(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("A negative bandwidth should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	NewBandedLU(poisson(3), -1, 1)
+}
@@ -0,0 +1,106 @@
+package solvers
+
+import (
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Solve "a * x = b" with the BiCGSTAB method, where "b" is a column vector.
+// When a zero division occurs, the status is "BrokenDown".
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "b" is not a column vector, validates.NOT_VECTOR_PANIC will be caused.
+func BiCGSTAB(a, b types.Matrix, s *Settings) *Result {
+	tolerance, iterations, x, m := prepare(a, b, s)
+
+	bv := vector(b)
+	bNorm := scaleOf(bv)
+
+	r := residual(a, bv, x)
+	shadow := make([]float64, len(r))
+	copy(shadow, r)
+
+	result := &Result{
+		solution:  x,
+		residuals: []float64{norm(r) / bNorm},
+		status:    NotConverged,
+	}
+
+	if result.residuals[0] <= tolerance {
+		result.status = Converged
+		return result
+	}
+
+	n := len(x)
+	p := make([]float64, n)
+	v := make([]float64, n)
+	sv := make([]float64, n)
+
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	for k := 0; k < iterations; k++ {
+		rhoNext := dot(shadow, r)
+		if rhoNext == 0 {
+			result.status = BrokenDown
+			return result
+		}
+
+		beta := (rhoNext / rho) * (alpha / omega)
+		rho = rhoNext
+
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+
+		pm := precondition(m, p)
+		v = apply(a, pm)
+
+		denominator := dot(shadow, v)
+		if denominator == 0 {
+			result.status = BrokenDown
+			return result
+		}
+		alpha = rho / denominator
+
+		for i := range sv {
+			sv[i] = r[i] - alpha*v[i]
+		}
+
+		if relative := norm(sv) / bNorm; relative <= tolerance {
+			axpy(alpha, pm, x)
+			result.residuals = append(result.residuals, relative)
+			result.status = Converged
+			return result
+		}
+
+		sm := precondition(m, sv)
+		t := apply(a, sm)
+
+		tt := dot(t, t)
+		if tt == 0 {
+			result.status = BrokenDown
+			return result
+		}
+		omega = dot(t, sv) / tt
+
+		axpy(alpha, pm, x)
+		axpy(omega, sm, x)
+
+		for i := range r {
+			r[i] = sv[i] - omega*t[i]
+		}
+
+		relative := norm(r) / bNorm
+		result.residuals = append(result.residuals, relative)
+
+		if relative <= tolerance {
+			result.status = Converged
+			return result
+		}
+
+		if omega == 0 {
+			result.status = BrokenDown
+			return result
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package solvers
+
+import (
+	"testing"
+)
+
+func TestBiCGSTABSolvesNonSymmetricSystem(t *testing.T) {
+	a := convection(30)
+	b := ones(30)
+
+	r := BiCGSTAB(a, b, &Settings{Tolerance: 1e-12})
+
+	if !r.Converged() {
+		t.Fatalf("The iteration should converge, but the status is %d.", r.Status())
+	}
+
+	checkSolution(t, a, r.Solution(), b, 1e-10)
+}
+
+func TestBiCGSTABReturnsZeroForZeroRightHandSide(t *testing.T) {
+	a := convection(5)
+	b := ones(5).Scalar(0)
+
+	r := BiCGSTAB(a, b, nil)
+
+	if !r.Converged() || r.Iterations() != 0 || !r.Solution().Equal(b) {
+		t.Fatal("The zero right-hand side should give the zero solution immediately.")
+	}
+}
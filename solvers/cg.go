@@ -0,0 +1,70 @@
+package solvers
+
+import (
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+// Solve "a * x = b" with the conjugate gradient method,
+// where "a" should be symmetric positive definite and "b" is a column vector.
+// The preconditioner should also be symmetric positive definite.
+// When "a" turns out not to be positive definite, the status is "BrokenDown".
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "b" is not a column vector, validates.NOT_VECTOR_PANIC will be caused.
+func ConjugateGradient(a, b types.Matrix, s *Settings) *Result {
+	tolerance, iterations, x, m := prepare(a, b, s)
+
+	bv := vector(b)
+	bNorm := scaleOf(bv)
+
+	r := residual(a, bv, x)
+	z := precondition(m, r)
+	p := make([]float64, len(z))
+	copy(p, z)
+
+	rz := dot(r, z)
+
+	result := &Result{
+		solution:  x,
+		residuals: []float64{norm(r) / bNorm},
+		status:    NotConverged,
+	}
+
+	if result.residuals[0] <= tolerance {
+		result.status = Converged
+		return result
+	}
+
+	for k := 0; k < iterations; k++ {
+		ap := apply(a, p)
+
+		pap := dot(p, ap)
+		if pap <= 0 {
+			result.status = BrokenDown
+			return result
+		}
+
+		alpha := rz / pap
+		axpy(alpha, p, x)
+		axpy(-alpha, ap, r)
+
+		relative := norm(r) / bNorm
+		result.residuals = append(result.residuals, relative)
+
+		if relative <= tolerance {
+			result.status = Converged
+			return result
+		}
+
+		z = precondition(m, r)
+
+		rzNext := dot(r, z)
+		beta := rzNext / rz
+		rz = rzNext
+
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+
+	return result
+}
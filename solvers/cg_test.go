@@ -0,0 +1,50 @@
+package solvers
+
+import (
+	"testing"
+)
+
+func TestConjugateGradientSolvesSymmetricPositiveDefiniteSystem(t *testing.T) {
+	a := poisson(20)
+	b := ones(20)
+
+	r := ConjugateGradient(a, b, &Settings{Tolerance: 1e-12})
+
+	if !r.Converged() {
+		t.Fatalf("The iteration should converge, but the status is %d.", r.Status())
+	}
+
+	// Without rounding errors, CG converges in at most n iterations.
+	if r.Iterations() > 20 {
+		t.Fatalf("The iteration should converge within 20 iterations, but takes %d.", r.Iterations())
+	}
+
+	checkSolution(t, a, r.Solution(), b, 1e-10)
+}
+
+func TestConjugateGradientBreaksDownForIndefiniteMatrix(t *testing.T) {
+	a := poisson(4).Scalar(-1)
+	b := ones(4)
+
+	if r := ConjugateGradient(a, b, nil); r.Status() == BrokenDown {
+		return
+	}
+
+	t.Fatal("A negative definite matrix should break the iteration down.")
+}
+
+func TestConjugateGradientRecordsDecreasingResiduals(t *testing.T) {
+	a := poisson(10)
+	b := ones(10)
+
+	r := ConjugateGradient(a, b, nil)
+	residuals := r.Residuals()
+
+	if residuals[0] != 1 {
+		t.Fatalf("The relative residual of zero initial guess should be 1, but is %f.", residuals[0])
+	}
+
+	if last := residuals[len(residuals)-1]; last > 1e-10 {
+		t.Fatalf("The last relative residual should be lower than the tolerance, but is %e.", last)
+	}
+}
@@ -0,0 +1,153 @@
+package solvers
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+const defaultRestart = 30
+
+// Solve "a * x = b" with the GMRES method restarted every "restart" iterations,
+// where "b" is a column vector.
+// When "restart" is not positive, 30 is used.
+// "restart" is limited to the size of system.
+// The residuals recorded during a cycle are the estimates given by the least squares problem.
+// The preconditioner is applied from the right, so the residuals are not affected by it.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "b" is not a column vector, validates.NOT_VECTOR_PANIC will be caused.
+func GMRES(a, b types.Matrix, restart int, s *Settings) *Result {
+	tolerance, iterations, x, m := prepare(a, b, s)
+
+	n := len(x)
+	if restart <= 0 {
+		restart = defaultRestart
+	}
+
+	if restart > n {
+		restart = n
+	}
+
+	bv := vector(b)
+	bNorm := scaleOf(bv)
+
+	r := residual(a, bv, x)
+	beta := norm(r)
+
+	result := &Result{
+		solution:  x,
+		residuals: []float64{beta / bNorm},
+		status:    NotConverged,
+	}
+
+	if beta/bNorm <= tolerance {
+		result.status = Converged
+		return result
+	}
+
+	// The Krylov basis, the Hessenberg matrix and the Givens rotations for a cycle.
+	v := make([][]float64, restart+1)
+	h := make([][]float64, restart+1)
+	for i := range h {
+		h[i] = make([]float64, restart)
+	}
+	cs := make([]float64, restart)
+	sn := make([]float64, restart)
+	g := make([]float64, restart+1)
+
+	for performed := 0; performed < iterations; {
+		for i := range g {
+			g[i] = 0
+		}
+		g[0] = beta
+
+		v[0] = make([]float64, n)
+		for i := range r {
+			v[0][i] = r[i] / beta
+		}
+
+		k := 0
+		for k < restart && performed < iterations {
+			w := apply(a, precondition(m, v[k]))
+
+			// Orthogonalize with the modified Gram-Schmidt process.
+			for i := 0; i <= k; i++ {
+				h[i][k] = dot(w, v[i])
+				axpy(-h[i][k], v[i], w)
+			}
+			h[k+1][k] = norm(w)
+
+			if h[k+1][k] != 0 {
+				v[k+1] = make([]float64, n)
+				for i := range w {
+					v[k+1][i] = w[i] / h[k+1][k]
+				}
+			}
+
+			// Apply the previous rotations and compute a new one.
+			for i := 0; i < k; i++ {
+				t := cs[i]*h[i][k] + sn[i]*h[i+1][k]
+				h[i+1][k] = -sn[i]*h[i][k] + cs[i]*h[i+1][k]
+				h[i][k] = t
+			}
+
+			d := math.Hypot(h[k][k], h[k+1][k])
+			if d == 0 {
+				result.status = BrokenDown
+				return result
+			}
+
+			cs[k] = h[k][k] / d
+			sn[k] = h[k+1][k] / d
+			h[k][k] = d
+			h[k+1][k] = 0
+
+			g[k+1] = -sn[k] * g[k]
+			g[k] = cs[k] * g[k]
+
+			k++
+			performed++
+
+			relative := math.Abs(g[k]) / bNorm
+			result.residuals = append(result.residuals, relative)
+
+			if relative <= tolerance || v[k] == nil {
+				break
+			}
+		}
+
+		// Solve the upper triangular system and update the solution.
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			y[i] = g[i]
+			for j := i + 1; j < k; j++ {
+				y[i] -= h[i][j] * y[j]
+			}
+			y[i] /= h[i][i]
+		}
+
+		u := make([]float64, n)
+		for i := 0; i < k; i++ {
+			axpy(y[i], v[i], u)
+		}
+		axpy(1, precondition(m, u), x)
+
+		for i := 1; i <= k; i++ {
+			v[i] = nil
+		}
+
+		r = residual(a, bv, x)
+		beta = norm(r)
+
+		if beta/bNorm <= tolerance {
+			result.status = Converged
+			return result
+		}
+
+		if beta == 0 {
+			break
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+package solvers
+
+import (
+	"testing"
+)
+
+func TestGMRESSolvesNonSymmetricSystem(t *testing.T) {
+	a := convection(30)
+	b := ones(30)
+
+	r := GMRES(a, b, 0, &Settings{Tolerance: 1e-12})
+
+	if !r.Converged() {
+		t.Fatalf("The iteration should converge, but the status is %d.", r.Status())
+	}
+
+	checkSolution(t, a, r.Solution(), b, 1e-10)
+}
+
+func TestGMRESConvergesWithRestart(t *testing.T) {
+	a := convection(40)
+	b := ones(40)
+
+	r := GMRES(a, b, 5, &Settings{Tolerance: 1e-10})
+
+	if !r.Converged() {
+		t.Fatalf("The restarted iteration should converge, but the status is %d.", r.Status())
+	}
+
+	if r.Iterations() <= 5 {
+		t.Fatalf("The iteration should be restarted, but takes only %d iterations.", r.Iterations())
+	}
+
+	checkSolution(t, a, r.Solution(), b, 1e-8)
+}
+
+func TestGMRESTerminatesOnLuckyBreakdown(t *testing.T) {
+	// The Krylov subspace of the identity matrix has the dimension 1.
+	a := poisson(5).Scalar(0)
+	for i := 0; i < 5; i++ {
+		a.Update(i, i, 2)
+	}
+	b := ones(5)
+
+	r := GMRES(a, b, 0, nil)
+
+	if !r.Converged() || r.Iterations() != 1 {
+		t.Fatalf("The iteration should converge in 1 iteration, but takes %d.", r.Iterations())
+	}
+
+	checkSolution(t, a, r.Solution(), b, 1e-12)
+}
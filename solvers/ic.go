@@ -0,0 +1,111 @@
+package solvers
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"IC" is the incomplete Cholesky preconditioner without fill-in, known as IC(0).
+The factor "l" has the same sparsity pattern as the lower triangle of coefficient matrix.
+*/
+type IC struct {
+	rows rows
+}
+
+// Compute the IC(0) factorization "a = l * l^T" restricted to the non-zero pattern of "a",
+// where "a" should be symmetric positive definite.
+// Only the lower triangle of "a" is read.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When a non-positive pivot appears, an error will be returned.
+func NewIC(a types.Matrix) (*IC, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	r := newRows(a)
+	n := len(r)
+
+	// Keep the lower triangle, where the diagonal element is the last in each row.
+	for i, row := range r {
+		lower := row[:0]
+		for _, e := range row {
+			if e.column <= i {
+				lower = append(lower, e)
+			}
+		}
+
+		if len(lower) == 0 || lower[len(lower)-1].column != i {
+			return nil, errors.New(NotPositiveDefiniteError)
+		}
+
+		r[i] = lower
+	}
+
+	for i := 0; i < n; i++ {
+		for index, e := range r[i] {
+			j := e.column
+
+			// Subtract the inner product of the rows "i" and "j" over the columns lower than "j".
+			s := e.element
+			p, q := 0, 0
+			for p < index && q < len(r[j])-1 {
+				switch {
+				case r[i][p].column < r[j][q].column:
+					p++
+				case r[i][p].column > r[j][q].column:
+					q++
+				default:
+					s -= r[i][p].element * r[j][q].element
+					p++
+					q++
+				}
+			}
+
+			if j < i {
+				r[i][index].element = s / r[j][len(r[j])-1].element
+				continue
+			}
+
+			if s <= 0 {
+				return nil, errors.New(NotPositiveDefiniteError)
+			}
+			r[i][index].element = math.Sqrt(s)
+		}
+	}
+
+	f := &IC{
+		rows: r,
+	}
+
+	return f, nil
+}
+
+func (f *IC) Solve(r types.Matrix) types.Matrix {
+	validates.ShapeShouldBeColumnVector(r)
+
+	z := vector(r)
+	n := len(z)
+
+	// Solve "l * y = r".
+	for i := 0; i < n; i++ {
+		row := f.rows[i]
+		for _, e := range row[:len(row)-1] {
+			z[i] -= e.element * z[e.column]
+		}
+		z[i] /= row[len(row)-1].element
+	}
+
+	// Solve "l^T * z = y".
+	for i := n - 1; i >= 0; i-- {
+		row := f.rows[i]
+		z[i] /= row[len(row)-1].element
+		for _, e := range row[:len(row)-1] {
+			z[e.column] -= e.element * z[i]
+		}
+	}
+
+	return dense.New(n, 1)(z...)
+}
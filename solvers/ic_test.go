@@ -0,0 +1,57 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestICReducesIterationsOfConjugateGradient(t *testing.T) {
+	a := poisson2D(12, uniform)
+	b := alternating(144)
+
+	p, err := NewIC(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	plain := ConjugateGradient(a, b, nil)
+	preconditioned := ConjugateGradient(a, b, &Settings{Preconditioner: p})
+
+	if !plain.Converged() || !preconditioned.Converged() {
+		t.Fatal("Both iterations should converge.")
+	}
+
+	if preconditioned.Iterations() >= plain.Iterations() {
+		t.Fatalf(
+			"The preconditioned iteration should be shorter, but takes %d against %d.",
+			preconditioned.Iterations(),
+			plain.Iterations(),
+		)
+	}
+
+	checkSolution(t, a, preconditioned.Solution(), b, 1e-8)
+}
+
+func TestICIsExactForTridiagonalMatrix(t *testing.T) {
+	a := poisson(6)
+	x := dense.New(6, 1)(1, -2, 3, -4, 5, -6)
+
+	p, err := NewIC(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSolution(t, a, p.Solve(a.Multiply(x)), a.Multiply(x), 1e-12)
+}
+
+func TestNewICFailsForIndefiniteMatrix(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		2, 1,
+	)
+
+	if _, err := NewIC(a); err == nil || err.Error() != NotPositiveDefiniteError {
+		t.Fatalf("An indefinite matrix should cause %s.", NotPositiveDefiniteError)
+	}
+}
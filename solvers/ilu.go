@@ -0,0 +1,103 @@
+package solvers
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"ILU" is the incomplete LU preconditioner without fill-in, known as ILU(0).
+The factors "l" and "u" have the same sparsity pattern as coefficient matrix,
+where "l" is unit lower triangular.
+*/
+type ILU struct {
+	rows     rows
+	diagonal []int
+}
+
+// Compute the ILU(0) factorization of "a" restricted to the non-zero pattern of "a".
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When a zero pivot appears, an error will be returned.
+func NewILU(a types.Matrix) (*ILU, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	r := newRows(a)
+	n := len(r)
+
+	diagonal := make([]int, n)
+	position := make([]int, n)
+	for j := range position {
+		position[j] = -1
+	}
+
+	for i := 0; i < n; i++ {
+		diagonal[i] = -1
+
+		for index, e := range r[i] {
+			position[e.column] = index
+			if e.column == i {
+				diagonal[i] = index
+			}
+		}
+
+		if diagonal[i] < 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+
+		for index := 0; index < diagonal[i]; index++ {
+			k := r[i][index].column
+
+			pivot := r[k][diagonal[k]].element
+			factor := r[i][index].element / pivot
+			r[i][index].element = factor
+
+			for _, e := range r[k][diagonal[k]+1:] {
+				if p := position[e.column]; p >= 0 {
+					r[i][p].element -= factor * e.element
+				}
+			}
+		}
+
+		if r[i][diagonal[i]].element == 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+
+		for _, e := range r[i] {
+			position[e.column] = -1
+		}
+	}
+
+	f := &ILU{
+		rows:     r,
+		diagonal: diagonal,
+	}
+
+	return f, nil
+}
+
+func (f *ILU) Solve(r types.Matrix) types.Matrix {
+	validates.ShapeShouldBeColumnVector(r)
+
+	z := vector(r)
+	n := len(z)
+
+	// Solve "l * y = r".
+	for i := 0; i < n; i++ {
+		for _, e := range f.rows[i][:f.diagonal[i]] {
+			z[i] -= e.element * z[e.column]
+		}
+	}
+
+	// Solve "u * z = y".
+	for i := n - 1; i >= 0; i-- {
+		for _, e := range f.rows[i][f.diagonal[i]+1:] {
+			z[i] -= e.element * z[e.column]
+		}
+		z[i] /= f.rows[i][f.diagonal[i]].element
+	}
+
+	return dense.New(n, 1)(z...)
+}
@@ -0,0 +1,90 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestILUReducesIterationsOfGMRES(t *testing.T) {
+	a := convection(50)
+	for i := 0; i < 50; i++ {
+		if i+7 < 50 {
+			a.Update(i, i+7, -0.4)
+		}
+	}
+	b := ones(50)
+
+	p, err := NewILU(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	plain := GMRES(a, b, 10, nil)
+	preconditioned := GMRES(a, b, 10, &Settings{Preconditioner: p})
+
+	if !plain.Converged() || !preconditioned.Converged() {
+		t.Fatal("Both iterations should converge.")
+	}
+
+	if preconditioned.Iterations() >= plain.Iterations() {
+		t.Fatalf(
+			"The preconditioned iteration should be shorter, but takes %d against %d.",
+			preconditioned.Iterations(),
+			plain.Iterations(),
+		)
+	}
+
+	checkSolution(t, a, preconditioned.Solution(), b, 1e-8)
+}
+
+func TestILUReducesIterationsOfBiCGSTAB(t *testing.T) {
+	a := poisson2D(8, func(row int) float64 { return float64(row + 1) })
+	b := ones(64)
+
+	p, err := NewILU(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	plain := BiCGSTAB(a, b, nil)
+	preconditioned := BiCGSTAB(a, b, &Settings{Preconditioner: p})
+
+	if !plain.Converged() || !preconditioned.Converged() {
+		t.Fatal("Both iterations should converge.")
+	}
+
+	if preconditioned.Iterations() >= plain.Iterations() {
+		t.Fatalf(
+			"The preconditioned iteration should be shorter, but takes %d against %d.",
+			preconditioned.Iterations(),
+			plain.Iterations(),
+		)
+	}
+
+	checkSolution(t, a, preconditioned.Solution(), b, 1e-8)
+}
+
+func TestILUIsExactForTridiagonalMatrix(t *testing.T) {
+	// ILU(0) causes no fill-in for a tridiagonal matrix, so it equals to the LU factorization.
+	a := convection(6)
+	x := dense.New(6, 1)(1, -2, 3, -4, 5, -6)
+
+	p, err := NewILU(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	checkSolution(t, a, p.Solve(a.Multiply(x)), a.Multiply(x), 1e-12)
+}
+
+func TestNewILUFailsForZeroPivot(t *testing.T) {
+	a := dense.New(2, 2)(
+		0, 1,
+		1, 1,
+	)
+
+	if _, err := NewILU(a); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A zero pivot should cause %s.", ZeroPivotError)
+	}
+}
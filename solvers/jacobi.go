@@ -0,0 +1,55 @@
+package solvers
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Jacobi" is the diagonal preconditioner "m = diag(a)".
+*/
+type Jacobi struct {
+	inverses []float64
+}
+
+// Create the Jacobi preconditioner from the diagonal elements of "a".
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When a diagonal element is zero, an error will be returned.
+func NewJacobi(a types.Matrix) (*Jacobi, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	inverses := make([]float64, a.Rows())
+
+	cursor := a.Diagonal()
+
+	for cursor.HasNext() {
+		element, row, _ := cursor.Get()
+
+		if element == 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+
+		inverses[row] = 1 / element
+	}
+
+	j := &Jacobi{
+		inverses: inverses,
+	}
+
+	return j, nil
+}
+
+func (j *Jacobi) Solve(r types.Matrix) types.Matrix {
+	validates.ShapeShouldBeColumnVector(r)
+
+	z := vector(r)
+
+	for i := range z {
+		z[i] *= j.inverses[i]
+	}
+
+	return dense.New(len(z), 1)(z...)
+}
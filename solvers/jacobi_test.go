@@ -0,0 +1,73 @@
+package solvers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestJacobiReducesIterationsOfConjugateGradient(t *testing.T) {
+	// Scale the Poisson matrix symmetrically with a widely varying diagonal.
+	n := 30
+	a := poisson(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			s := math.Pow(10, float64(i%5)/2) * math.Pow(10, float64(j%5)/2)
+			a.Update(i, j, a.Get(i, j)*s)
+		}
+	}
+	b := ones(n)
+
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	plain := ConjugateGradient(a, b, &Settings{MaxIterations: 1000})
+	preconditioned := ConjugateGradient(a, b, &Settings{MaxIterations: 1000, Preconditioner: p})
+
+	if !plain.Converged() || !preconditioned.Converged() {
+		t.Fatal("Both iterations should converge.")
+	}
+
+	if preconditioned.Iterations() >= plain.Iterations() {
+		t.Fatalf(
+			"The preconditioned iteration should be shorter, but takes %d against %d.",
+			preconditioned.Iterations(),
+			plain.Iterations(),
+		)
+	}
+
+	checkSolution(t, a, preconditioned.Solution(), b, 1e-8)
+}
+
+func TestJacobiSolvesDiagonalSystem(t *testing.T) {
+	a := dense.New(3, 3)(
+		2, 1, 0,
+		1, 4, 1,
+		0, 1, 8,
+	)
+
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if p.Solve(dense.New(3, 1)(2, 4, 8)).Equal(dense.New(3, 1)(1, 1, 1)) {
+		return
+	}
+
+	t.Fatal("The Jacobi preconditioner should divide by the diagonal elements.")
+}
+
+func TestNewJacobiFailsForZeroDiagonal(t *testing.T) {
+	a := dense.New(2, 2)(
+		0, 1,
+		1, 0,
+	)
+
+	if _, err := NewJacobi(a); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A zero diagonal element should cause %s.", ZeroPivotError)
+	}
+}
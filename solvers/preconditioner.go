@@ -0,0 +1,73 @@
+package solvers
+
+import (
+	"sort"
+
+	"github.com/mitsuse/matrix-go/internal/types"
+)
+
+/*
+"Preconditioner" is the interface for approximations "m" of coefficient matrix,
+which are easy to invert and accelerate iterative solvers.
+*/
+type Preconditioner interface {
+	// Solve "m * z = r" for the column vector "r" and return "z".
+	Solve(r types.Matrix) types.Matrix
+}
+
+// "entry" is a non-zero element in a row of sparse matrix.
+type entry struct {
+	column  int
+	element float64
+}
+
+// "rows" is a row-wise sparse representation of matrix.
+// The entries of each row are sorted by the column.
+type rows [][]entry
+
+// Create the row-wise sparse representation of "m" by reading only non-zero elements.
+func newRows(m types.Matrix) rows {
+	r := make(rows, m.Rows())
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		r[row] = append(r[row], entry{column: column, element: element})
+	}
+
+	for _, row := range r {
+		sort.Sort(byColumn(row))
+	}
+
+	return r
+}
+
+// Return the diagonal elements.
+func (r rows) diagonal() []float64 {
+	d := make([]float64, len(r))
+
+	for i, row := range r {
+		for _, e := range row {
+			if e.column == i {
+				d[i] = e.element
+			}
+		}
+	}
+
+	return d
+}
+
+type byColumn []entry
+
+func (b byColumn) Len() int {
+	return len(b)
+}
+
+func (b byColumn) Less(i, j int) bool {
+	return b[i].column < b[j].column
+}
+
+func (b byColumn) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
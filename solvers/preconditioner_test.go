@@ -0,0 +1,50 @@
+package solvers
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create the matrix of the two-dimensional Poisson equation on the "n x n" grid
+// with the five-point stencil, where each row of grid is scaled by "scale".
+func poisson2D(n int, scale func(row int) float64) *dense.Matrix {
+	m := dense.Zeros(n*n, n*n)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			k := i*n + j
+			s := scale(i)
+
+			m.Update(k, k, 4*s)
+
+			if j > 0 {
+				m.Update(k, k-1, -s)
+			}
+			if j < n-1 {
+				m.Update(k, k+1, -s)
+			}
+			if i > 0 {
+				m.Update(k, k-n, -s)
+			}
+			if i < n-1 {
+				m.Update(k, k+n, -s)
+			}
+		}
+	}
+
+	return m
+}
+
+func uniform(row int) float64 {
+	return 1
+}
+
+// Create the column vector whose elements vary with the period 7.
+func alternating(n int) *dense.Matrix {
+	b := dense.Zeros(n, 1)
+
+	for i := 0; i < n; i++ {
+		b.Update(i, 0, float64(i%7-3))
+	}
+
+	return b
+}
@@ -0,0 +1,211 @@
+/*
+Package "solvers" provides solvers for systems of linear equations "a * x = b".
+
+Iterative solvers access the coefficient matrix only through "(Matrix).Multiply",
+so they work with any implementation of "types.Matrix".
+Instead of causing a panic, they report whether the iteration converged with "Status".
+
+Direct solvers for triangular, tridiagonal and banded systems read only the relevant elements
+and report a zero pivot as an error.
+*/
+package solvers
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	defaultTolerance     = 1e-10
+	defaultIterationRate = 10
+)
+
+/*
+"Status" represents how an iterative solver terminated.
+*/
+type Status int
+
+const (
+	// The relative residual became lower than the tolerance.
+	Converged Status = iota
+
+	// The iteration reached the maximum number of iterations.
+	NotConverged
+
+	// The iteration cannot be continued because of a zero division.
+	BrokenDown
+)
+
+const (
+	ZeroPivotError           = "ZeroPivotError"
+	NotPositiveDefiniteError = "NotPositiveDefiniteError"
+	InvalidRelaxationError   = "InvalidRelaxationError"
+	NotUniquelySolvableError = "NotUniquelySolvableError"
+)
+
+/*
+"Settings" controls an iterative solver.
+The zero value is available,
+where the tolerance is 1e-10, the maximum number of iterations is ten times the size of system,
+the initial guess is the zero vector and no preconditioner is used.
+*/
+type Settings struct {
+	// The tolerance for the relative residual "|b - a * x| / |b|".
+	Tolerance float64
+
+	// The maximum number of iterations.
+	MaxIterations int
+
+	// The initial guess of the solution as a column vector.
+	Initial types.Matrix
+
+	// The preconditioner applied in every iteration.
+	Preconditioner Preconditioner
+}
+
+/*
+"Result" is the outcome of an iterative solver.
+*/
+type Result struct {
+	solution  []float64
+	residuals []float64
+	status    Status
+}
+
+// Return the approximate solution as a column vector.
+func (r *Result) Solution() *dense.Matrix {
+	return dense.New(len(r.solution), 1)(r.solution...)
+}
+
+// Return the history of relative residuals.
+// The first element is the relative residual of the initial guess,
+// and each of the rest is recorded after an iteration.
+func (r *Result) Residuals() []float64 {
+	residuals := make([]float64, len(r.residuals))
+	copy(residuals, r.residuals)
+
+	return residuals
+}
+
+// Return the number of performed iterations.
+func (r *Result) Iterations() int {
+	return len(r.residuals) - 1
+}
+
+// Return the status of termination.
+func (r *Result) Status() Status {
+	return r.status
+}
+
+// Check whether the iteration converged or not.
+func (r *Result) Converged() bool {
+	return r.status == Converged
+}
+
+// Validate the system "a * x = b" and the settings,
+// and return the tolerance, the maximum number of iterations, the initial guess and the preconditioner.
+func prepare(a, b types.Matrix, s *Settings) (tolerance float64, iterations int, x []float64, p Preconditioner) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeColumnVector(b)
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	if s == nil {
+		s = &Settings{}
+	}
+
+	tolerance = s.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultTolerance
+	}
+
+	iterations = s.MaxIterations
+	if iterations <= 0 {
+		iterations = defaultIterationRate * a.Rows()
+	}
+
+	if s.Initial == nil {
+		x = make([]float64, a.Rows())
+	} else {
+		validates.ShapeShouldBeSame(b, s.Initial)
+		x = vector(s.Initial)
+	}
+
+	return tolerance, iterations, x, s.Preconditioner
+}
+
+// Copy the elements of the column vector "v" into a slice.
+func vector(v types.Matrix) []float64 {
+	x := make([]float64, v.Rows())
+
+	cursor := v.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, _ := cursor.Get()
+		x[row] = element
+	}
+
+	return x
+}
+
+// Compute "a * x" through "(Matrix).Multiply".
+func apply(a types.Matrix, x []float64) []float64 {
+	return vector(a.Multiply(dense.New(len(x), 1)(x...)))
+}
+
+// Solve "m * z = r" with the preconditioner "m".
+// When no preconditioner is given, return a copy of "r".
+func precondition(p Preconditioner, r []float64) []float64 {
+	if p == nil {
+		z := make([]float64, len(r))
+		copy(z, r)
+
+		return z
+	}
+
+	return vector(p.Solve(dense.New(len(r), 1)(r...)))
+}
+
+// Compute "b - a * x".
+func residual(a types.Matrix, b, x []float64) []float64 {
+	r := apply(a, x)
+
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+
+	return r
+}
+
+// Return the norm of "b" used to compute relative residuals.
+// For the zero vector, the absolute residual is used instead.
+func scaleOf(b []float64) float64 {
+	if s := norm(b); s > 0 {
+		return s
+	}
+
+	return 1
+}
+
+func dot(x, y []float64) float64 {
+	s := 0.0
+
+	for i := range x {
+		s += x[i] * y[i]
+	}
+
+	return s
+}
+
+func norm(x []float64) float64 {
+	return math.Sqrt(dot(x, x))
+}
+
+// Compute "y + alpha * x" in place of "y".
+func axpy(alpha float64, x, y []float64) {
+	for i := range x {
+		y[i] += alpha * x[i]
+	}
+}
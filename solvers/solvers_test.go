@@ -0,0 +1,113 @@
+package solvers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create the matrix of the one-dimensional Poisson equation, which is symmetric positive definite.
+func poisson(n int) *dense.Matrix {
+	m := dense.Zeros(n, n)
+
+	for i := 0; i < n; i++ {
+		m.Update(i, i, 2)
+
+		if i > 0 {
+			m.Update(i, i-1, -1)
+		}
+
+		if i < n-1 {
+			m.Update(i, i+1, -1)
+		}
+	}
+
+	return m
+}
+
+// Create the matrix of the one-dimensional convection-diffusion equation, which is non-symmetric.
+func convection(n int) *dense.Matrix {
+	m := dense.Zeros(n, n)
+
+	for i := 0; i < n; i++ {
+		m.Update(i, i, 3)
+
+		if i > 0 {
+			m.Update(i, i-1, -1.5)
+		}
+
+		if i < n-1 {
+			m.Update(i, i+1, -0.5)
+		}
+	}
+
+	return m
+}
+
+func ones(n int) *dense.Matrix {
+	v := dense.Zeros(n, 1)
+
+	for i := 0; i < n; i++ {
+		v.Update(i, 0, 1)
+	}
+
+	return v
+}
+
+// Check whether "x" satisfies "a * x = b" within the given relative tolerance.
+func checkSolution(t *testing.T, a, x, b types.Matrix, tolerance float64) {
+	r := a.Multiply(x).Subtract(b)
+
+	rNorm, bNorm := 0.0, 0.0
+	for i := 0; i < b.Rows(); i++ {
+		rNorm += r.Get(i, 0) * r.Get(i, 0)
+		bNorm += b.Get(i, 0) * b.Get(i, 0)
+	}
+
+	if math.Sqrt(rNorm/bNorm) > tolerance {
+		t.Fatalf("The solution should satisfy a * x = b, but the relative residual is %e.", math.Sqrt(rNorm/bNorm))
+	}
+}
+
+func TestSettingsUseTheInitialGuess(t *testing.T) {
+	a := poisson(4)
+	b := a.Multiply(ones(4))
+
+	r := ConjugateGradient(a, b, &Settings{Initial: ones(4)})
+
+	if r.Converged() && r.Iterations() == 0 {
+		return
+	}
+
+	t.Fatal("The exact initial guess should converge without iterations.")
+}
+
+func TestSettingsLimitTheIterations(t *testing.T) {
+	a := convection(32)
+	b := ones(32)
+
+	r := BiCGSTAB(a, b, &Settings{Tolerance: 1e-300, MaxIterations: 3})
+
+	if r.Status() == NotConverged && r.Iterations() == 3 && len(r.Residuals()) == 4 {
+		return
+	}
+
+	t.Fatalf("The iteration should stop after 3 iterations, but stopped with %d.", r.Iterations())
+}
+
+func TestSolverCausesPanicForNonVector(t *testing.T) {
+	a := poisson(3)
+	b := dense.Zeros(3, 2)
+
+	defer func() {
+		if p := recover(); p == validates.NOT_VECTOR_PANIC {
+			return
+		}
+
+		t.Fatalf("A non-vector right-hand side should cause %s.", validates.NOT_VECTOR_PANIC)
+	}()
+	ConjugateGradient(a, b, nil)
+}
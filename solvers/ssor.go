@@ -0,0 +1,86 @@
+package solvers
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"SSOR" is the symmetric successive over-relaxation preconditioner
+"m = (d + omega * l) * d^-1 * (d + omega * u) / (omega * (2 - omega))",
+where "d", "l" and "u" are the diagonal, strictly lower and strictly upper parts of coefficient matrix.
+*/
+type SSOR struct {
+	rows     rows
+	diagonal []float64
+	omega    float64
+}
+
+// Create the SSOR preconditioner of "a" with the relaxation factor "omega" in (0, 2).
+// When "omega" is 1, this is the symmetric Gauss-Seidel preconditioner.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused.
+// When a diagonal element is zero, an error will be returned.
+func NewSSOR(a types.Matrix, omega float64) (*SSOR, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	if omega <= 0 || omega >= 2 {
+		return nil, errors.New(InvalidRelaxationError)
+	}
+
+	r := newRows(a)
+	d := r.diagonal()
+
+	for _, element := range d {
+		if element == 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+	}
+
+	s := &SSOR{
+		rows:     r,
+		diagonal: d,
+		omega:    omega,
+	}
+
+	return s, nil
+}
+
+func (s *SSOR) Solve(r types.Matrix) types.Matrix {
+	validates.ShapeShouldBeColumnVector(r)
+
+	z := vector(r)
+	n := len(z)
+
+	// Solve "(d + omega * l) * y = r".
+	for i := 0; i < n; i++ {
+		for _, e := range s.rows[i] {
+			if e.column >= i {
+				break
+			}
+			z[i] -= s.omega * e.element * z[e.column]
+		}
+		z[i] /= s.diagonal[i]
+	}
+
+	// Multiply by "d" and solve "(d + omega * u) * z = d * y".
+	for i := n - 1; i >= 0; i-- {
+		z[i] *= s.diagonal[i]
+
+		for _, e := range s.rows[i] {
+			if e.column > i {
+				z[i] -= s.omega * e.element * z[e.column]
+			}
+		}
+		z[i] /= s.diagonal[i]
+	}
+
+	scale := s.omega * (2 - s.omega)
+	for i := range z {
+		z[i] *= scale
+	}
+
+	return dense.New(n, 1)(z...)
+}
@@ -0,0 +1,55 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestSSORReducesIterationsOfConjugateGradient(t *testing.T) {
+	a := poisson2D(12, uniform)
+	b := alternating(144)
+
+	p, err := NewSSOR(a, 1.5)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	plain := ConjugateGradient(a, b, nil)
+	preconditioned := ConjugateGradient(a, b, &Settings{Preconditioner: p})
+
+	if !plain.Converged() || !preconditioned.Converged() {
+		t.Fatal("Both iterations should converge.")
+	}
+
+	if preconditioned.Iterations() >= plain.Iterations() {
+		t.Fatalf(
+			"The preconditioned iteration should be shorter, but takes %d against %d.",
+			preconditioned.Iterations(),
+			plain.Iterations(),
+		)
+	}
+
+	checkSolution(t, a, preconditioned.Solution(), b, 1e-8)
+}
+
+func TestNewSSORFailsForInvalidRelaxation(t *testing.T) {
+	a := poisson(3)
+
+	for _, omega := range []float64{0, 2, -1} {
+		if _, err := NewSSOR(a, omega); err == nil || err.Error() != InvalidRelaxationError {
+			t.Fatalf("The relaxation factor %f should cause %s.", omega, InvalidRelaxationError)
+		}
+	}
+}
+
+func TestNewSSORFailsForZeroDiagonal(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 1,
+		1, 0,
+	)
+
+	if _, err := NewSSOR(a, 1); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A zero diagonal element should cause %s.", ZeroPivotError)
+	}
+}
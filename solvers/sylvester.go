@@ -0,0 +1,147 @@
+package solvers
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/decompositions"
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/arrays"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Solve the Sylvester equation "a * x + x * b = c" with the Bartels-Stewart algorithm,
+// where "a" is "n x n", "b" is "m x m" and "c" is "n x m".
+// The equation is reduced to the quasi-triangular one with the real Schur decompositions of "a" and "b".
+// When "a" or "b" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "c" doesn't have the shape "n x m", validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When "a" and "-b" have a common eigenvalue numerically, the solution is not unique and an error will be returned.
+func SolveSylvester(a, b, c types.Matrix) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeSquare(b)
+	validates.ShapeShouldBeMultipliable(a, c)
+	validates.ShapeShouldBeMultipliable(c, b)
+
+	sa, err := decompositions.NewSchur(a)
+	if err != nil {
+		return nil, err
+	}
+
+	sb, err := decompositions.NewSchur(b)
+	if err != nil {
+		return nil, err
+	}
+
+	r, u := arrays.Convert(sa.T()), arrays.Convert(sa.Z())
+	s, v := arrays.Convert(sb.T()), arrays.Convert(sb.Z())
+
+	// Transform the equation into "r * y + y * s = f", where "x = u * y * v^T".
+	f := u.Transpose().Multiply(arrays.Convert(c)).Multiply(v)
+
+	y, err := solveQuasiTriangularSylvester(r, s, f, blocksOf(sa), blocksOf(sb))
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Multiply(y).Multiply(v.Transpose()).Matrix(), nil
+}
+
+// Solve the Lyapunov equation "a * x + x * a^T + q = 0",
+// which is the Sylvester equation with "b = a^T" and "c = -q".
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "q" doesn't have the same shape as "a", validates.DIFFERENT_SIZE_PANIC will be caused.
+// When the sum of two eigenvalues of "a" is zero, the solution is not unique and an error will be returned.
+func SolveLyapunov(a, q types.Matrix) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeSame(a, q)
+
+	c := dense.Zeros(q.Shape())
+
+	cursor := q.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		c.Update(row, column, -element)
+	}
+
+	return SolveSylvester(a, a.Transpose(), c)
+}
+
+// Return the starting indices of the diagonal blocks of the quasi-triangular matrix in "s",
+// followed by the size of matrix.
+func blocksOf(s *decompositions.Schur) []int {
+	values := s.Eigenvalues()
+	blocks := make([]int, 0, len(values)+1)
+
+	for i := 0; i < len(values); i++ {
+		blocks = append(blocks, i)
+
+		if imag(values[i]) > 0 {
+			i++
+		}
+	}
+
+	return append(blocks, len(values))
+}
+
+// Solve "r * y + y * s = f" block by block,
+// where "r" and "s" are upper quasi-triangular with the diagonal blocks "rb" and "sb".
+func solveQuasiTriangularSylvester(r, s, f *arrays.Array, rb, sb []int) (*arrays.Array, error) {
+	y := arrays.Zeros(f.Shape())
+
+	// A pivot is regarded as zero in the same way as the numerical rank.
+	tolerance := float64(r.Rows()+s.Rows()) * arrays.Epsilon * math.Max(r.MaxAbs(), s.MaxAbs())
+
+	for k := 0; k < len(sb)-1; k++ {
+		c0, c1 := sb[k], sb[k+1]
+
+		for l := len(rb) - 2; l >= 0; l-- {
+			r0, r1 := rb[l], rb[l+1]
+			p, q := r1-r0, c1-c0
+
+			// Solve "r_ll * y_lk + y_lk * s_kk = rhs" as the system of size "p * q"
+			// with the Kronecker product, where "y_lk" is vectorized in column-major order.
+			system := arrays.Zeros(p*q, p*q)
+			rhs := arrays.Zeros(p*q, 1)
+
+			for b := 0; b < q; b++ {
+				for a := 0; a < p; a++ {
+					i, j := r0+a, c0+b
+
+					e := f.Get(i, j)
+					for m := r1; m < r.Columns(); m++ {
+						e -= r.Get(i, m) * y.Get(m, j)
+					}
+					for m := 0; m < c0; m++ {
+						e -= y.Get(i, m) * s.Get(m, j)
+					}
+					rhs.Update(a+p*b, 0, e)
+
+					row := a + p*b
+					for d := 0; d < p; d++ {
+						system.Update(row, d+p*b, system.Get(row, d+p*b)+r.Get(i, r0+d))
+					}
+					for d := 0; d < q; d++ {
+						system.Update(row, a+p*d, system.Get(row, a+p*d)+s.Get(c0+d, j))
+					}
+				}
+			}
+
+			lu := arrays.NewLU(system, tolerance)
+			if lu.Singular() {
+				return nil, errors.New(NotUniquelySolvableError)
+			}
+
+			x := lu.Solve(rhs)
+
+			for b := 0; b < q; b++ {
+				for a := 0; a < p; a++ {
+					y.Update(r0+a, c0+b, x.Get(a+p*b, 0))
+				}
+			}
+		}
+	}
+
+	return y, nil
+}
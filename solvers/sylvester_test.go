@@ -0,0 +1,113 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func equalApproximately(m, n types.Matrix, tolerance float64) bool {
+	cursor := m.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if d := element - n.Get(row, column); d > tolerance || d < -tolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSolveSylvesterWithComplexEigenvalues(t *testing.T) {
+	// Both "a" and "b" have a pair of complex conjugate eigenvalues.
+	a := dense.New(3, 3)(
+		1, -2, 0,
+		2, 1, 1,
+		0, 0, 3,
+	)
+	b := dense.New(2, 2)(
+		4, 1,
+		-3, 2,
+	)
+	x := dense.New(3, 2)(
+		1, -1,
+		2, 0,
+		-3, 5,
+	)
+
+	c := a.Multiply(x).Add(x.Multiply(b))
+
+	solution, err := SolveSylvester(a, b, c)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(solution, x, 1e-10) {
+		t.Fatal("The solution of Sylvester equation should be found.")
+	}
+}
+
+func TestSolveSylvesterFailsForCommonEigenvalue(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 1,
+		0, 2,
+	)
+	b := dense.New(2, 2)(
+		-2, 0,
+		5, 3,
+	)
+
+	if _, err := SolveSylvester(a, b, dense.Zeros(2, 2)); err == nil || err.Error() != NotUniquelySolvableError {
+		t.Fatalf("The eigenvalues 2 of a and -2 of b should cause %s.", NotUniquelySolvableError)
+	}
+}
+
+func TestSolveSylvesterCausesPanicForInvalidShape(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_MULTIPLIABLE_PANIC {
+			t.Fatalf("The invalid shape of c should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+		}
+	}()
+
+	SolveSylvester(dense.Zeros(2, 2), dense.Zeros(3, 3), dense.Zeros(3, 2))
+}
+
+func TestSolveLyapunovForStableMatrix(t *testing.T) {
+	a := dense.New(3, 3)(
+		-1, 2, 0,
+		-2, -1, 1,
+		0, 0, -3,
+	)
+	q := dense.New(3, 3)(
+		2, 1, 0,
+		1, 2, 1,
+		0, 1, 2,
+	)
+
+	x, err := SolveLyapunov(a, q)
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	if !equalApproximately(a.Multiply(x).Add(x.Multiply(a.Transpose())).Add(q), dense.Zeros(3, 3), 1e-10) {
+		t.Fatal("The solution should satisfy a * x + x * a^T + q = 0.")
+	}
+
+	if !equalApproximately(x, x.Transpose(), 1e-10) {
+		t.Fatal("The solution should be symmetric for symmetric q.")
+	}
+}
+
+func TestSolveLyapunovFailsForOppositeEigenvalues(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 0,
+		0, -1,
+	)
+
+	if _, err := SolveLyapunov(a, dense.Zeros(2, 2)); err == nil || err.Error() != NotUniquelySolvableError {
+		t.Fatalf("The eigenvalues 1 and -1 should cause %s.", NotUniquelySolvableError)
+	}
+}
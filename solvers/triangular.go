@@ -0,0 +1,130 @@
+package solvers
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"TriangularSettings" specifies a triangular system "t * x = b".
+The zero value means a lower triangular system with the diagonal read from the matrix.
+*/
+type TriangularSettings struct {
+	// Read the upper triangle instead of the lower one.
+	Upper bool
+
+	// Assume that the diagonal elements are one without reading them.
+	UnitDiagonal bool
+
+	// Solve "t^T * x = b" instead of "t * x = b".
+	Transpose bool
+}
+
+// Solve "l * x = b" by forward substitution, where "l" is lower triangular.
+// This is a shorthand of "SolveTriangular" with the zero value of settings.
+func ForwardSubstitution(l, b types.Matrix) (*dense.Matrix, error) {
+	return SolveTriangular(l, b, TriangularSettings{})
+}
+
+// Solve "u * x = b" by back substitution, where "u" is upper triangular.
+func BackSubstitution(u, b types.Matrix) (*dense.Matrix, error) {
+	return SolveTriangular(u, b, TriangularSettings{Upper: true})
+}
+
+// Solve the triangular system specified by "s" for each column of "b".
+// Only non-zero elements in the specified triangle of "t" are read,
+// so the cost is proportional to the number of them for each column of "b".
+// When "t" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "t" and "b" are not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When a diagonal element is zero, an error will be returned.
+func SolveTriangular(t, b types.Matrix, s TriangularSettings) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(t)
+	validates.ShapeShouldBeMultipliable(t, b)
+
+	n, columns := b.Shape()
+	x := elementsOf(b)
+
+	strict := make([][]entry, n)
+	diagonal := make([]float64, n)
+
+	for i, row := range newRows(t) {
+		for _, e := range row {
+			switch {
+			case e.column == i:
+				diagonal[i] = e.element
+			case (e.column < i) != s.Upper:
+				strict[i] = append(strict[i], e)
+			}
+		}
+	}
+
+	if s.UnitDiagonal {
+		for i := range diagonal {
+			diagonal[i] = 1
+		}
+	} else {
+		for _, element := range diagonal {
+			if element == 0 {
+				return nil, errors.New(ZeroPivotError)
+			}
+		}
+	}
+
+	// The transposed system of lower triangular matrix is upper triangular and vice versa.
+	forward := s.Upper == s.Transpose
+
+	for k := 0; k < n; k++ {
+		i := k
+		if !forward {
+			i = n - 1 - k
+		}
+
+		xi := x[i*columns : (i+1)*columns]
+
+		if s.Transpose {
+			// Scatter the solved row to the rows depending on it.
+			for c := range xi {
+				xi[c] /= diagonal[i]
+			}
+
+			for _, e := range strict[i] {
+				xj := x[e.column*columns : (e.column+1)*columns]
+				for c := range xj {
+					xj[c] -= e.element * xi[c]
+				}
+			}
+		} else {
+			// Gather the solved rows.
+			for _, e := range strict[i] {
+				xj := x[e.column*columns : (e.column+1)*columns]
+				for c := range xi {
+					xi[c] -= e.element * xj[c]
+				}
+			}
+
+			for c := range xi {
+				xi[c] /= diagonal[i]
+			}
+		}
+	}
+
+	return dense.New(n, columns)(x...), nil
+}
+
+// Copy the elements of "m" into a slice in row-major order.
+func elementsOf(m types.Matrix) []float64 {
+	columns := m.Columns()
+	x := make([]float64, m.Rows()*columns)
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		x[row*columns+column] = element
+	}
+
+	return x
+}
@@ -0,0 +1,106 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestSolveTriangularSolvesEachSystem(t *testing.T) {
+	l := dense.New(3, 3)(
+		2, 9, 9,
+		1, 4, 9,
+		-1, 3, 5,
+	)
+	u := l.Transpose()
+
+	x := dense.New(3, 2)(
+		1, 2,
+		-1, 0,
+		3, -2,
+	)
+
+	// The elements out of the triangle are ignored.
+	lower := dense.New(3, 3)(
+		2, 0, 0,
+		1, 4, 0,
+		-1, 3, 5,
+	)
+	upper := lower.Transpose()
+	unitLower := dense.New(3, 3)(
+		1, 0, 0,
+		1, 1, 0,
+		-1, 3, 1,
+	)
+
+	tests := []struct {
+		t        types.Matrix
+		settings TriangularSettings
+		product  types.Matrix
+	}{
+		{l, TriangularSettings{}, lower},
+		{u, TriangularSettings{Upper: true}, upper},
+		{l, TriangularSettings{Transpose: true}, upper},
+		{u, TriangularSettings{Upper: true, Transpose: true}, lower},
+		{l, TriangularSettings{UnitDiagonal: true}, unitLower},
+		{l, TriangularSettings{UnitDiagonal: true, Transpose: true}, unitLower.Transpose()},
+	}
+
+	for _, test := range tests {
+		b := test.product.Multiply(x)
+
+		solution, err := SolveTriangular(test.t, b, test.settings)
+		if err != nil {
+			t.Fatalf("An unexpected error occured: %s", err)
+		}
+
+		if !solution.Equal(x) {
+			t.Fatalf("The system %+v should be solved.", test.settings)
+		}
+	}
+}
+
+func TestForwardAndBackSubstitution(t *testing.T) {
+	l := dense.New(2, 2)(
+		2, 0,
+		1, 4,
+	)
+
+	x, err := ForwardSubstitution(l, dense.New(2, 1)(4, 10))
+	if err != nil || !x.Equal(dense.New(2, 1)(2, 2)) {
+		t.Fatal("The forward substitution should solve the lower triangular system.")
+	}
+
+	x, err = BackSubstitution(l.Transpose(), dense.New(2, 1)(4, 8))
+	if err != nil || !x.Equal(dense.New(2, 1)(1, 2)) {
+		t.Fatal("The back substitution should solve the upper triangular system.")
+	}
+}
+
+func TestSolveTriangularFailsForZeroDiagonal(t *testing.T) {
+	l := dense.New(2, 2)(
+		1, 0,
+		1, 0,
+	)
+
+	if _, err := ForwardSubstitution(l, dense.New(2, 1)(1, 1)); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A zero diagonal element should cause %s.", ZeroPivotError)
+	}
+
+	// The diagonal is not read when it is assumed to be one.
+	if _, err := SolveTriangular(l, dense.New(2, 1)(1, 1), TriangularSettings{UnitDiagonal: true}); err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+}
+
+func TestSolveTriangularCausesPanicForNotMultipliable(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.NOT_MULTIPLIABLE_PANIC {
+			t.Fatalf("The different number of rows should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+		}
+	}()
+
+	ForwardSubstitution(dense.Zeros(2, 2), dense.Zeros(3, 1))
+}
@@ -0,0 +1,65 @@
+package solvers
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Solve "a * x = b" for each column of "b" with the Thomas algorithm, where "a" is tridiagonal.
+// Only the diagonal, sub-diagonal and super-diagonal elements of "a" are read with "(Matrix).Get",
+// so the cost is O(n) for each column of "b".
+// No pivoting is performed, so "a" should be diagonally dominant or symmetric positive definite.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "a" and "b" are not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+// When a zero pivot appears, an error will be returned.
+func SolveTridiagonal(a, b types.Matrix) (*dense.Matrix, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	n, columns := b.Shape()
+	x := elementsOf(b)
+
+	// The modified super-diagonal elements.
+	c := make([]float64, n)
+
+	for i := 0; i < n; i++ {
+		pivot := a.Get(i, i)
+		xi := x[i*columns : (i+1)*columns]
+
+		if i > 0 {
+			l := a.Get(i, i-1)
+			pivot -= l * c[i-1]
+
+			xp := x[(i-1)*columns : i*columns]
+			for k := range xi {
+				xi[k] -= l * xp[k]
+			}
+		}
+
+		if pivot == 0 {
+			return nil, errors.New(ZeroPivotError)
+		}
+
+		if i < n-1 {
+			c[i] = a.Get(i, i+1) / pivot
+		}
+
+		for k := range xi {
+			xi[k] /= pivot
+		}
+	}
+
+	for i := n - 2; i >= 0; i-- {
+		xi := x[i*columns : (i+1)*columns]
+		xn := x[(i+1)*columns : (i+2)*columns]
+
+		for k := range xi {
+			xi[k] -= c[i] * xn[k]
+		}
+	}
+
+	return dense.New(n, columns)(x...), nil
+}
@@ -0,0 +1,44 @@
+package solvers
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestSolveTridiagonalSolvesManySystems(t *testing.T) {
+	a := convection(6)
+	x := dense.New(6, 2)(
+		1, 0,
+		-2, 1,
+		3, 0,
+		-4, 1,
+		5, 0,
+		-6, 1,
+	)
+
+	solution, err := SolveTridiagonal(a, a.Multiply(x))
+	if err != nil {
+		t.Fatalf("An unexpected error occured: %s", err)
+	}
+
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 2; j++ {
+			if d := solution.Get(i, j) - x.Get(i, j); d > 1e-12 || d < -1e-12 {
+				t.Fatal("The tridiagonal system should be solved.")
+			}
+		}
+	}
+}
+
+func TestSolveTridiagonalFailsForZeroPivot(t *testing.T) {
+	a := dense.New(3, 3)(
+		1, 1, 0,
+		1, 1, 1,
+		0, 1, 1,
+	)
+
+	if _, err := SolveTridiagonal(a, ones(3)); err == nil || err.Error() != ZeroPivotError {
+		t.Fatalf("A zero pivot should cause %s.", ZeroPivotError)
+	}
+}
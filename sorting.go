@@ -0,0 +1,193 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create the matrix whose rows are sorted by the elements of "column" in ascending order,
+// and return it with the permutation "p" where the i-th row is the "p[i]"-th row of "m".
+// The sort is stable, and NaN is placed after the other elements.
+// When "column" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func SortRowsBy(m Matrix, column int) (*dense.Matrix, []int) {
+	rows, columns := m.Shape()
+	validates.IndexShouldBeInRange(rows, columns, 0, column)
+
+	x := elementsOf(m)
+	p := argSort(rows, func(i int) float64 {
+		return x[i*columns+column]
+	}, false)
+
+	return PermuteRows(m, p), p
+}
+
+// Return the permutations sorting each row or column of "m" in ascending order.
+// For "EachRow", the i-th permutation consists of the columns of the i-th row,
+// and for "EachColumn", it consists of the rows of the i-th column.
+// The sort is stable, and NaN is placed after the other elements.
+func ArgSort(m Matrix, axis Axis) [][]int {
+	rows, columns := m.Shape()
+	x := elementsOf(m)
+
+	permutations := [][]int{}
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		permutations = append(permutations, argSort(n, func(j int) float64 {
+			return x[indexes(j)]
+		}, false))
+	})
+
+	return permutations
+}
+
+// Return the "k" largest elements of each row or column of "m" in descending order with their indexes.
+// The values are a "rows x k" matrix for "EachRow" and a "k x columns" matrix for "EachColumn",
+// and the indexes are the columns for "EachRow" and the rows for "EachColumn".
+// Ties are broken by the smaller index, and NaN is regarded as smaller than the other elements.
+// When "k" is not in [1, length of row or column], validates.OUT_OF_RANGE_PANIC will be caused.
+func TopK(m Matrix, axis Axis, k int) (*dense.Matrix, [][]int) {
+	rows, columns := m.Shape()
+
+	size := columns
+	if axis == EachColumn {
+		size = rows
+	}
+
+	if k < 1 || k > size {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	x := elementsOf(m)
+
+	var values *dense.Matrix
+	if axis == EachRow {
+		values = dense.Zeros(rows, k)
+	} else {
+		values = dense.Zeros(k, columns)
+	}
+
+	indexes := [][]int{}
+
+	eachLine(rows, columns, axis, func(line func(j int) int, n int) {
+		p := argSort(n, func(j int) float64 {
+			return x[line(j)]
+		}, true)[:k]
+
+		i := len(indexes)
+		for j, index := range p {
+			if axis == EachRow {
+				values.Update(i, j, x[line(index)])
+			} else {
+				values.Update(j, i, x[line(index)])
+			}
+		}
+
+		indexes = append(indexes, p)
+	})
+
+	return values, indexes
+}
+
+// Return the row vector of the distinct elements of "m" in ascending order and the numbers of them.
+// All NaN elements are counted as a value placed at the end.
+func Unique(m Matrix) (*dense.Matrix, []int) {
+	x := elementsOf(m)
+	sort.Sort(&byValue{values: x})
+
+	values := []float64{}
+	counts := []int{}
+
+	for i, element := range x {
+		if i > 0 && (element == x[i-1] || math.IsNaN(element) && math.IsNaN(x[i-1])) {
+			counts[len(counts)-1]++
+			continue
+		}
+
+		values = append(values, element)
+		counts = append(counts, 1)
+	}
+
+	return dense.New(1, len(values))(values...), counts
+}
+
+// Create the matrix whose i-th row is the "p[i]"-th row of "m".
+// When "p" has an index out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func PermuteRows(m Matrix, p []int) *dense.Matrix {
+	rows, columns := m.Shape()
+	x := elementsOf(m)
+	y := make([]float64, 0, len(p)*columns)
+
+	for _, row := range p {
+		validates.IndexShouldBeInRange(rows, columns, row, 0)
+		y = append(y, x[row*columns:(row+1)*columns]...)
+	}
+
+	return dense.New(len(p), columns)(y...)
+}
+
+// Create the matrix whose j-th column is the "p[j]"-th column of "m".
+// When "p" has an index out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func PermuteColumns(m Matrix, p []int) *dense.Matrix {
+	return PermuteRows(m.Transpose(), p).Transpose().(*dense.Matrix)
+}
+
+// Return the permutation of [0, n) sorting "key" stably.
+func argSort(n int, key func(i int) float64, descending bool) []int {
+	p := make([]int, n)
+	keys := make([]float64, n)
+
+	for i := range p {
+		p[i] = i
+		keys[i] = key(i)
+	}
+
+	sort.Stable(&byKey{permutation: p, keys: keys, descending: descending})
+
+	return p
+}
+
+// Return whether "x" precedes "y", where NaN is placed after the other values in both orders.
+func precedes(x, y float64, descending bool) bool {
+	if descending {
+		return x > y || !math.IsNaN(x) && math.IsNaN(y)
+	}
+
+	return x < y || !math.IsNaN(x) && math.IsNaN(y)
+}
+
+type byKey struct {
+	permutation []int
+	keys        []float64
+	descending  bool
+}
+
+func (b *byKey) Len() int {
+	return len(b.permutation)
+}
+
+func (b *byKey) Less(i, j int) bool {
+	return precedes(b.keys[b.permutation[i]], b.keys[b.permutation[j]], b.descending)
+}
+
+func (b *byKey) Swap(i, j int) {
+	b.permutation[i], b.permutation[j] = b.permutation[j], b.permutation[i]
+}
+
+type byValue struct {
+	values []float64
+}
+
+func (b *byValue) Len() int {
+	return len(b.values)
+}
+
+func (b *byValue) Less(i, j int) bool {
+	return precedes(b.values[i], b.values[j], false)
+}
+
+func (b *byValue) Swap(i, j int) {
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+}
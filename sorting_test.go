@@ -0,0 +1,107 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestSortRowsBy(t *testing.T) {
+	m := dense.New(4, 2)(
+		1, 3,
+		2, 1,
+		3, math.NaN(),
+		4, 1,
+	)
+
+	sorted, p := SortRowsBy(cursorOnly{m}, 1)
+
+	if !equalIndexes(p, []int{1, 3, 0, 2}) {
+		t.Fatalf("The permutation should sort rows stably with NaN at the end, but is %v.", p)
+	}
+
+	if !sorted.View(0, 0, 4, 1).Equal(dense.New(4, 1)(2, 4, 1, 3)) {
+		t.Fatal("The rows should be sorted by the column.")
+	}
+
+	// The permutation is reused for another matrix.
+	labels := dense.New(4, 1)(10, 20, 30, 40)
+	if !PermuteRows(labels, p).Equal(dense.New(4, 1)(20, 40, 10, 30)) {
+		t.Fatal("PermuteRows should reorder the rows with the permutation.")
+	}
+}
+
+func TestArgSort(t *testing.T) {
+	m := dense.New(2, 3)(
+		3, 1, 2,
+		0, 0, -1,
+	)
+
+	rows := ArgSort(m, EachRow)
+	if len(rows) != 2 || !equalIndexes(rows[0], []int{1, 2, 0}) || !equalIndexes(rows[1], []int{2, 0, 1}) {
+		t.Fatalf("ArgSort should return the permutations of columns for each row, but returns %v.", rows)
+	}
+
+	columns := ArgSort(m.Transpose(), EachColumn)
+	if len(columns) != 2 || !equalIndexes(columns[0], []int{1, 2, 0}) {
+		t.Fatalf("ArgSort should return the permutations of rows for each column, but returns %v.", columns)
+	}
+
+	if !PermuteColumns(m, rows[0]).Equal(dense.New(2, 3)(1, 2, 3, 0, -1, 0)) {
+		t.Fatal("PermuteColumns should reorder the columns with the permutation.")
+	}
+}
+
+func TestTopK(t *testing.T) {
+	m := dense.New(2, 4)(
+		0.1, 0.7, math.NaN(), 0.2,
+		0.5, 0.5, 0.9, 0,
+	)
+
+	values, indexes := TopK(m, EachRow, 2)
+
+	if !values.Equal(dense.New(2, 2)(0.7, 0.2, 0.9, 0.5)) {
+		t.Fatal("TopK should return the largest elements of each row in descending order.")
+	}
+
+	if !equalIndexes(indexes[0], []int{1, 3}) || !equalIndexes(indexes[1], []int{2, 0}) {
+		t.Fatalf("TopK should return the columns of the largest elements, but returns %v.", indexes)
+	}
+
+	values, indexes = TopK(m, EachColumn, 1)
+
+	if !values.View(0, 0, 1, 2).Equal(dense.New(1, 2)(0.5, 0.7)) || !equalIndexes(indexes[2], []int{1}) {
+		t.Fatal("TopK should return the largest elements of each column.")
+	}
+}
+
+func TestTopKCausesPanicForTooLargeK(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("A too large k should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	TopK(dense.Zeros(2, 3), EachColumn, 3)
+}
+
+func TestUniqueCountsValues(t *testing.T) {
+	nan := math.NaN()
+
+	m := dense.New(2, 4)(
+		2, 0, nan, 2,
+		-1, 0, nan, 2,
+	)
+
+	values, counts := Unique(m)
+
+	if values.Columns() != 4 || !values.View(0, 0, 1, 3).Equal(dense.New(1, 3)(-1, 0, 2)) || !math.IsNaN(values.Get(0, 3)) {
+		t.Fatal("Unique should return the distinct elements in ascending order with NaN at the end.")
+	}
+
+	if !equalIndexes(counts, []int{1, 2, 3, 2}) {
+		t.Fatalf("Unique should count each value, but counts %v.", counts)
+	}
+}
@@ -0,0 +1,217 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// "Interpolation" specifies the value of a quantile between two elements as NumPy.
+type Interpolation int
+
+const (
+	// Interpolate the two elements linearly.
+	Linear Interpolation = iota
+
+	// Take the lower element.
+	Lower
+
+	// Take the higher element.
+	Higher
+
+	// Take the nearest element, or the one with the even index for a tie.
+	Nearest
+
+	// Take the mean of the two elements.
+	Midpoint
+)
+
+// "NaNPolicy" specifies how NaN elements are handled in statistics.
+type NaNPolicy int
+
+const (
+	// Return NaN when the elements include NaN.
+	PropagateNaN NaNPolicy = iota
+
+	// Ignore NaN elements, and return NaN only when all the elements are NaN.
+	OmitNaN
+)
+
+// Return the "q"-quantile of all elements of "m" for "q" in [0, 1].
+// When "q" is out of [0, 1], validates.OUT_OF_RANGE_PANIC will be caused.
+func Quantile(m Matrix, q float64, method Interpolation, nan NaNPolicy) float64 {
+	validateProbability(q)
+
+	return quantile(elementsOf(m), q, method, nan)
+}
+
+// Return the vector of "q"-quantiles of each row or column of "m" for "q" in [0, 1].
+// When "q" is out of [0, 1], validates.OUT_OF_RANGE_PANIC will be caused.
+func QuantileAlong(m Matrix, axis Axis, q float64, method Interpolation, nan NaNPolicy) Matrix {
+	validateProbability(q)
+
+	rows, columns := m.Shape()
+	x := elementsOf(m)
+
+	quantiles := []float64{}
+
+	eachLine(rows, columns, axis, func(indexes func(j int) int, n int) {
+		line := make([]float64, n)
+		for j := range line {
+			line[j] = x[indexes(j)]
+		}
+
+		quantiles = append(quantiles, quantile(line, q, method, nan))
+	})
+
+	return vectorOf(axis, len(quantiles), func(i int) float64 {
+		return quantiles[i]
+	})
+}
+
+// Return the median of all elements of "m",
+// which is the mean of the two middle elements for an even number of elements.
+func Median(m Matrix, nan NaNPolicy) float64 {
+	return Quantile(m, 0.5, Linear, nan)
+}
+
+// Return the vector of medians of each row or column of "m".
+func MedianAlong(m Matrix, axis Axis, nan NaNPolicy) Matrix {
+	return QuantileAlong(m, axis, 0.5, Linear, nan)
+}
+
+// Count the elements of "m" in "bins" bins of equal width between the minimum and maximum elements,
+// and return the counts and the "bins + 1" edges of bins.
+// Each bin includes the lower edge, and the last one also includes the upper edge.
+// NaN and infinite elements are not counted, and the number of them is returned as "excluded".
+// When the range is empty, it is extended by the larger of 0.5 and "bins" units in the last place on both sides,
+// or set to [0, 1] without finite elements.
+// When "bins" is not positive, validates.OUT_OF_RANGE_PANIC will be caused.
+func Histogram(m Matrix, bins int) (counts []int, edges []float64, excluded int) {
+	if bins < 1 {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	min, max := math.Inf(1), math.Inf(-1)
+
+	visit(m, false, func(element float64, row, column int) {
+		if math.IsNaN(element) || math.IsInf(element, 0) {
+			excluded++
+			return
+		}
+
+		min = math.Min(min, element)
+		max = math.Max(max, element)
+	})
+
+	switch {
+	case min > max:
+		min, max = 0, 1
+	case min == max:
+		// Adding 0.5 doesn't change large elements, so the range is also extended relative to the magnitude.
+		a := math.Abs(min)
+		delta := math.Max(0.5, float64(bins)*(a-math.Nextafter(a, 0)))
+		min, max = math.Max(min-delta, -math.MaxFloat64), math.Min(max+delta, math.MaxFloat64)
+	}
+
+	// The range is divided before the subtraction, which overflows for extreme finite elements.
+	width := max/float64(bins) - min/float64(bins)
+
+	edges = make([]float64, bins+1)
+	for i := range edges {
+		t := float64(i) / float64(bins)
+		edges[i] = min*(1-t) + max*t
+	}
+	edges[0], edges[bins] = min, max
+
+	counts = make([]int, bins)
+
+	visit(m, false, func(element float64, row, column int) {
+		if math.IsNaN(element) || math.IsInf(element, 0) {
+			return
+		}
+
+		counts[binOf(element, edges, width)]++
+	})
+
+	return counts, edges, excluded
+}
+
+// Find the bin including "element" in "edges".
+// The bin is guessed from "width" and corrected to agree with the rounded edges.
+func binOf(element float64, edges []float64, width float64) int {
+	bins := len(edges) - 1
+
+	bin := 0
+	if guess := element/width - edges[0]/width; width > 0 && guess > 0 {
+		bin = int(math.Min(guess, float64(bins-1)))
+	}
+
+	for bin > 0 && element < edges[bin] {
+		bin--
+	}
+
+	for bin < bins-1 && element >= edges[bin+1] {
+		bin++
+	}
+
+	return bin
+}
+
+func validateProbability(q float64) {
+	if q >= 0 && q <= 1 {
+		return
+	}
+
+	panic(validates.OUT_OF_RANGE_PANIC)
+}
+
+// Return the "q"-quantile of "x", which is sorted in place.
+func quantile(x []float64, q float64, method Interpolation, nan NaNPolicy) float64 {
+	y := x[:0]
+
+	for _, element := range x {
+		if !math.IsNaN(element) {
+			y = append(y, element)
+		} else if nan == PropagateNaN {
+			return math.NaN()
+		}
+	}
+
+	if len(y) == 0 {
+		return math.NaN()
+	}
+
+	sort.Float64s(y)
+
+	h := float64(len(y)-1) * q
+	lower := int(math.Floor(h))
+	higher := int(math.Ceil(h))
+	fraction := h - float64(lower)
+
+	a, b := y[lower], y[higher]
+
+	switch method {
+	case Lower:
+		return a
+	case Higher:
+		return b
+	case Nearest:
+		if fraction < 0.5 || fraction == 0.5 && lower%2 == 0 {
+			return a
+		}
+		return b
+	}
+
+	// Avoid "inf - inf" for the equal elements.
+	if a == b {
+		return a
+	}
+
+	if method == Midpoint {
+		return (a + b) / 2
+	}
+
+	return a + fraction*(b-a)
+}
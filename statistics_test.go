@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+type quantileTest struct {
+	method Interpolation
+	result float64
+}
+
+func TestQuantileInterpolations(t *testing.T) {
+	// The 0.3-quantile lies at 0.9 between the elements 1 and 2 of [1, 2, 3, 4].
+	m := dense.New(2, 2)(
+		4, 1,
+		3, 2,
+	)
+
+	tests := []*quantileTest{
+		{method: Linear, result: 1.9},
+		{method: Lower, result: 1},
+		{method: Higher, result: 2},
+		{method: Nearest, result: 2},
+		{method: Midpoint, result: 1.5},
+	}
+
+	for _, test := range tests {
+		if q := Quantile(m, 0.3, test.method, PropagateNaN); math.Abs(q-test.result) > 1e-15 {
+			t.Fatalf("The quantile with the method %d should be %v, but is %v.", test.method, test.result, q)
+		}
+	}
+
+	// The median lies at 1.5 between the indexes 1 and 2.
+	if q := Quantile(m, 0.5, Nearest, PropagateNaN); q != 3 {
+		t.Fatalf("Nearest should take the element with the even index for a tie, but takes %v.", q)
+	}
+}
+
+func TestMedianHandlesNaNExplicitly(t *testing.T) {
+	nan := math.NaN()
+
+	m := dense.New(1, 4)(3, nan, 1, 2)
+
+	if !math.IsNaN(Median(m, PropagateNaN)) {
+		t.Fatal("PropagateNaN should return NaN for elements including NaN.")
+	}
+
+	if median := Median(m, OmitNaN); median != 2 {
+		t.Fatalf("OmitNaN should ignore NaN, but the median is %v.", median)
+	}
+
+	if !math.IsNaN(Median(dense.New(1, 1)(nan), OmitNaN)) {
+		t.Fatal("OmitNaN should return NaN when all the elements are NaN.")
+	}
+}
+
+func TestMedianAndQuantileAlong(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 10,
+		5, 30,
+		3, 20,
+	).Transpose()
+
+	if !MedianAlong(m, EachRow, PropagateNaN).Equal(dense.New(2, 1)(3, 20)) {
+		t.Fatal("MedianAlong should return the medians of rows.")
+	}
+
+	if !QuantileAlong(m, EachColumn, 1, Linear, PropagateNaN).Equal(dense.New(1, 3)(10, 30, 20)) {
+		t.Fatal("QuantileAlong should return the maxima of columns for q = 1.")
+	}
+}
+
+func TestQuantileCausesPanicForInvalidProbability(t *testing.T) {
+	defer func() {
+		if p := recover(); p != validates.OUT_OF_RANGE_PANIC {
+			t.Fatalf("An invalid probability should cause %s.", validates.OUT_OF_RANGE_PANIC)
+		}
+	}()
+
+	Quantile(dense.Zeros(2, 2), 1.5, Linear, PropagateNaN)
+}
+
+func TestHistogram(t *testing.T) {
+	m := dense.New(2, 4)(
+		0, 1, 2, 3,
+		4, math.NaN(), 4, math.Inf(1),
+	)
+
+	counts, edges, excluded := Histogram(cursorOnly{m}, 4)
+
+	if !equalIndexes(counts, []int{1, 1, 1, 3}) {
+		t.Fatalf("The counts should be [1 1 1 3], but are %v.", counts)
+	}
+
+	if len(edges) != 5 || edges[0] != 0 || edges[1] != 1 || edges[4] != 4 {
+		t.Fatalf("The edges should divide the range equally, but are %v.", edges)
+	}
+
+	if excluded != 2 {
+		t.Fatalf("NaN and infinite elements should be excluded, but %d are excluded.", excluded)
+	}
+}
+
+func TestHistogramHandlesExtremeFiniteElements(t *testing.T) {
+	m := dense.New(1, 3)(-math.MaxFloat64, 0, math.MaxFloat64)
+
+	counts, edges, excluded := Histogram(m, 4)
+
+	if !equalIndexes(counts, []int{1, 0, 1, 1}) {
+		t.Fatalf("The counts should be [1 0 1 1], but are %v.", counts)
+	}
+
+	for _, edge := range edges {
+		if math.IsInf(edge, 0) || math.IsNaN(edge) {
+			t.Fatalf("The edges should be finite, but are %v.", edges)
+		}
+	}
+
+	if excluded != 0 {
+		t.Fatalf("No element should be excluded, but %d are excluded.", excluded)
+	}
+}
+
+func TestHistogramCountsElementsOnEdgesInUpperBins(t *testing.T) {
+	m := dense.New(1, 5)(0, 0.1, 0.2, 0.3, 1)
+
+	counts, edges, _ := Histogram(m, 10)
+
+	for i, count := range counts {
+		expected := 0
+		for _, element := range []float64{0, 0.1, 0.2, 0.3, 1} {
+			if element >= edges[i] && (element < edges[i+1] || i == len(counts)-1) {
+				expected++
+			}
+		}
+
+		if count != expected {
+			t.Fatalf("The counts %v should agree with the edges %v.", counts, edges)
+		}
+	}
+
+	if counts[3] != 1 {
+		t.Fatalf("0.3 should be counted in [0.3, 0.4), but the counts are %v.", counts)
+	}
+}
+
+func TestHistogramExtendsEmptyRangeOfLargeElements(t *testing.T) {
+	counts, edges, _ := Histogram(dense.New(1, 2)(1e300, 1e300), 4)
+
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i-1] < edges[i]) || math.IsInf(edges[i], 0) {
+			t.Fatalf("The edges should be finite and increasing, but are %v.", edges)
+		}
+	}
+
+	if counts[0]+counts[1]+counts[2]+counts[3] != 2 {
+		t.Fatalf("All the elements should be counted, but the counts are %v.", counts)
+	}
+}
+
+func TestHistogramCountsSubnormalRange(t *testing.T) {
+	counts, edges, _ := Histogram(dense.New(1, 2)(0, 5e-324), 4)
+
+	if edges[0] != 0 || edges[4] != 5e-324 || counts[0]+counts[1]+counts[2]+counts[3] != 2 || counts[3] != 1 {
+		t.Fatalf("All the elements should be counted in the bins of the edges, but the counts are %v.", counts)
+	}
+}
+
+func TestHistogramExtendsEmptyRange(t *testing.T) {
+	counts, edges, _ := Histogram(dense.New(1, 2)(3, 3), 2)
+
+	if !equalIndexes(counts, []int{0, 2}) || edges[0] != 2.5 || edges[2] != 3.5 {
+		t.Fatalf("The range should be extended to [2.5, 3.5], but the edges are %v.", edges)
+	}
+}